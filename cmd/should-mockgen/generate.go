@@ -0,0 +1,318 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const mockImportPath string = "github.com/pjbgf/go-test/should/mock"
+
+// options controls what is generated.
+type options struct {
+	iface     string
+	mockName  string
+	pkgName   string
+	pkgPath   string
+	generator string
+}
+
+// listedPackage holds the fields read from go list.
+type listedPackage struct {
+	Dir        string
+	ImportPath string
+	Name       string
+	GoFiles    []string
+}
+
+// loadPackage type-checks the package in source, which can be a directory or an import path.
+func loadPackage(source string) (*types.Package, error) {
+	out, err := exec.Command("go", "list", "-json", source).Output() // #nosec G204
+	if err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			return nil, fmt.Errorf("go list %s: %s", source, bytes.TrimSpace(exitErr.Stderr))
+		}
+		return nil, err
+	}
+
+	var listed listedPackage
+	if err := json.Unmarshal(out, &listed); err != nil {
+		return nil, err
+	}
+
+	fset := token.NewFileSet()
+	files := make([]*ast.File, 0, len(listed.GoFiles))
+	for _, name := range listed.GoFiles {
+		f, err := parser.ParseFile(fset, filepath.Join(listed.Dir, name), nil, 0)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, f)
+	}
+
+	conf := types.Config{Importer: importer.ForCompiler(fset, "source", nil)}
+	return conf.Check(listed.ImportPath, fset, files, nil)
+}
+
+// generate renders a mock for the interface opts.iface found in pkg.
+func generate(pkg *types.Package, opts options) ([]byte, error) {
+	obj := pkg.Scope().Lookup(opts.iface)
+	if obj == nil {
+		return nil, fmt.Errorf("%s not found in %s", opts.iface, pkg.Path())
+	}
+
+	named, ok := obj.Type().(*types.Named)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a named type", opts.iface)
+	}
+	iface, ok := named.Underlying().(*types.Interface)
+	if !ok {
+		return nil, fmt.Errorf("%s is not an interface", opts.iface)
+	}
+
+	g := &generator{
+		opts:    opts,
+		imports: map[string]importSpec{mockImportPath: {name: "mock"}},
+		names:   map[string]string{"mock": mockImportPath},
+	}
+	if g.opts.pkgPath == "" {
+		g.opts.pkgPath = pkg.Path()
+	}
+
+	typeParams, typeArgs := g.typeParams(named.TypeParams())
+	receiver := "m *" + opts.mockName + typeArgs
+
+	var body bytes.Buffer
+	fmt.Fprintf(&body, "// %s is a mock implementation of %s.\n", opts.mockName, g.objectName(named.Obj()))
+	fmt.Fprintf(&body, "type %s%s struct {\n\tmock.Mock\n}\n", opts.mockName, typeParams)
+	if typeParams == "" {
+		fmt.Fprintf(&body, "\nvar _ %s = (*%s)(nil)\n", g.typeString(named), opts.mockName)
+	}
+
+	methods := make([]*types.Func, iface.NumMethods())
+	for i := range methods {
+		methods[i] = iface.Method(i)
+	}
+	sort.Slice(methods, func(i, j int) bool { return methods[i].Name() < methods[j].Name() })
+
+	for _, method := range methods {
+		if !method.Exported() && method.Pkg() != nil && method.Pkg().Path() != g.opts.pkgPath {
+			return nil, fmt.Errorf("%s has unexported method %s from another package", opts.iface, method.Name())
+		}
+		g.writeMethod(&body, receiver, method)
+	}
+
+	var out bytes.Buffer
+	fmt.Fprintf(&out, "// Code generated by %s. DO NOT EDIT.\n\n", opts.generator)
+	fmt.Fprintf(&out, "package %s\n\n", opts.pkgName)
+	out.WriteString(g.importBlock())
+	out.Write(body.Bytes())
+
+	source, err := format.Source(out.Bytes())
+	if err != nil {
+		return out.Bytes(), fmt.Errorf("formatting generated code: %w", err)
+	}
+
+	return source, nil
+}
+
+type generator struct {
+	opts options
+
+	// imports holds every import by path, and names maps their names in the output back to their paths.
+	imports map[string]importSpec
+	names   map[string]string
+}
+
+// importSpec is how an import is named in the output.
+type importSpec struct {
+	name    string
+	aliased bool
+}
+
+// typeString prints t as seen from the output package, recording the imports it needs.
+func (g *generator) typeString(t types.Type) string {
+	return types.TypeString(t, func(pkg *types.Package) string {
+		if pkg.Path() == g.opts.pkgPath {
+			return ""
+		}
+		return g.importName(pkg)
+	})
+}
+
+// importName records pkg as an import and returns its name in the output, which is
+// the package name unless another import, such as mock, already uses it.
+func (g *generator) importName(pkg *types.Package) string {
+	if spec, ok := g.imports[pkg.Path()]; ok {
+		return spec.name
+	}
+
+	name := pkg.Name()
+	for i := 2; g.names[name] != ""; i++ {
+		name = pkg.Name() + strconv.Itoa(i)
+	}
+
+	g.imports[pkg.Path()] = importSpec{name: name, aliased: name != pkg.Name()}
+	g.names[name] = pkg.Path()
+	return name
+}
+
+// objectName returns the name of obj as seen from the output package.
+func (g *generator) objectName(obj types.Object) string {
+	if obj.Pkg() == nil || obj.Pkg().Path() == g.opts.pkgPath {
+		return obj.Name()
+	}
+
+	return obj.Pkg().Name() + "." + obj.Name()
+}
+
+// typeParams returns the type parameter list, e.g. "[K comparable, V any]",
+// and the matching type argument list, e.g. "[K, V]".
+func (g *generator) typeParams(list *types.TypeParamList) (params, args string) {
+	if list.Len() == 0 {
+		return "", ""
+	}
+
+	names := make([]string, list.Len())
+	decls := make([]string, list.Len())
+	for i := 0; i < list.Len(); i++ {
+		param := list.At(i)
+		names[i] = param.Obj().Name()
+		decls[i] = names[i] + " " + g.typeString(param.Constraint())
+	}
+
+	return "[" + strings.Join(decls, ", ") + "]", "[" + strings.Join(names, ", ") + "]"
+}
+
+func (g *generator) writeMethod(w *bytes.Buffer, receiver string, method *types.Func) {
+	sig := method.Type().(*types.Signature)
+
+	paramTypes := make([]string, sig.Params().Len())
+	for i := range paramTypes {
+		paramTypes[i] = g.typeString(sig.Params().At(i).Type())
+		if sig.Variadic() && i == len(paramTypes)-1 {
+			paramTypes[i] = "..." + g.typeString(sig.Params().At(i).Type().(*types.Slice).Elem())
+		}
+	}
+
+	results := make([]string, sig.Results().Len())
+	for i := range results {
+		results[i] = g.typeString(sig.Results().At(i).Type())
+	}
+
+	args := g.paramNames(sig.Params())
+	params := make([]string, len(args))
+	for i, name := range args {
+		params[i] = name + " " + paramTypes[i]
+	}
+
+	resultList := strings.Join(results, ", ")
+	if len(results) > 1 {
+		resultList = "(" + resultList + ")"
+	}
+
+	if len(results) == 0 {
+		fmt.Fprintf(w, "\n// %s records the call.\n", method.Name())
+	} else {
+		fmt.Fprintf(w, "\n// %s records the call and returns the results set with On(%q).Return(...).\n", method.Name(), method.Name())
+	}
+	fmt.Fprintf(w, "func (%s) %s(%s) %s {\n", receiver, method.Name(), strings.Join(params, ", "), resultList)
+
+	call := fmt.Sprintf("m.Called(%q", method.Name())
+	if len(args) > 0 {
+		call += ", " + strings.Join(args, ", ")
+	}
+	call += ")"
+
+	if len(results) == 0 {
+		fmt.Fprintf(w, "\t%s\n}\n", call)
+		return
+	}
+
+	fmt.Fprintf(w, "\tr := %s\n", call)
+	values := make([]string, len(results))
+	for i, result := range results {
+		values[i] = "r" + strconv.Itoa(i)
+		fmt.Fprintf(w, "\t%s, _ := r.Get(%d).(%s)\n", values[i], i, result)
+	}
+	fmt.Fprintf(w, "\treturn %s\n}\n", strings.Join(values, ", "))
+}
+
+// paramNames returns the names of params in the generated method. Blank parameters, and those
+// that would shadow the receiver m, the locals r and rN or an import, are renamed to pN.
+func (g *generator) paramNames(params *types.Tuple) []string {
+	names := make([]string, params.Len())
+	taken := make(map[string]bool, params.Len())
+	for i := range names {
+		names[i] = params.At(i).Name()
+		taken[names[i]] = true
+	}
+
+	for i, name := range names {
+		if !g.reserved(name) {
+			continue
+		}
+
+		name = "p" + strconv.Itoa(i)
+		for n := 2; taken[name] || g.reserved(name); n++ {
+			name = "p" + strconv.Itoa(i) + "_" + strconv.Itoa(n)
+		}
+		names[i], taken[name] = name, true
+	}
+	return names
+}
+
+// reserved reports whether name cannot be used as a parameter in generated methods.
+func (g *generator) reserved(name string) bool {
+	if name == "" || name == "_" || name == "m" || name == "r" || g.names[name] != "" {
+		return true
+	}
+
+	_, err := strconv.Atoi(strings.TrimPrefix(name, "r"))
+	return strings.HasPrefix(name, "r") && err == nil
+}
+
+// importBlock lists the standard library imports first, then the others.
+func (g *generator) importBlock() string {
+	var std, others []string
+	for path := range g.imports {
+		if strings.Contains(strings.SplitN(path, "/", 2)[0], ".") {
+			others = append(others, path)
+		} else {
+			std = append(std, path)
+		}
+	}
+	sort.Strings(std)
+	sort.Strings(others)
+
+	var b strings.Builder
+	b.WriteString("import (\n")
+	for i, group := range [][]string{std, others} {
+		if i > 0 && len(std) > 0 {
+			b.WriteString("\n")
+		}
+		for _, path := range group {
+			if spec := g.imports[path]; spec.aliased {
+				fmt.Fprintf(&b, "\t%s %q\n", spec.name, path)
+			} else {
+				fmt.Fprintf(&b, "\t%q\n", path)
+			}
+		}
+	}
+	b.WriteString(")\n\n")
+
+	return b.String()
+}
@@ -0,0 +1,32 @@
+// Code generated by should-mockgen. DO NOT EDIT.
+
+package example
+
+import (
+	"github.com/pjbgf/go-test/should/mock"
+)
+
+// CacheMock is a mock implementation of Cache.
+type CacheMock[K comparable, V any] struct {
+	mock.Mock
+}
+
+// Get records the call and returns the results set with On("Get").Return(...).
+func (m *CacheMock[K, V]) Get(key K) (V, bool) {
+	r := m.Called("Get", key)
+	r0, _ := r.Get(0).(V)
+	r1, _ := r.Get(1).(bool)
+	return r0, r1
+}
+
+// Keys records the call and returns the results set with On("Keys").Return(...).
+func (m *CacheMock[K, V]) Keys() []K {
+	r := m.Called("Keys")
+	r0, _ := r.Get(0).([]K)
+	return r0
+}
+
+// Set records the call.
+func (m *CacheMock[K, V]) Set(key K, value V) {
+	m.Called("Set", key, value)
+}
@@ -0,0 +1,42 @@
+package example
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pjbgf/go-test/should"
+	"github.com/pjbgf/go-test/should/mock"
+)
+
+func TestGeneratedMocks(t *testing.T) {
+	should := should.New(t)
+
+	store := &StoreMock{}
+	store.On("Delete", mock.Any(), []string{"a", "b"}).Return(2, nil)
+	store.On("Logf", "deleted %d", []interface{}{2})
+
+	deleted, err := store.Delete(context.Background(), "a", "b")
+	store.Logf("deleted %d", deleted)
+
+	should.NotError(err)
+	should.BeEqual(2, deleted)
+	should.MeetExpectations(store)
+
+	cache := &CacheMock[string, int]{}
+	cache.On("Get", "a").Return(1, true)
+
+	value, ok := cache.Get("a")
+
+	should.BeEqual(1, value)
+	should.BeTrue(ok)
+	should.MeetExpectations(cache)
+
+	renderer := &RendererMock{}
+	renderer.On("Render", 1, "page", true, mock.Any(), mock.Any()).Return("rendered", nil)
+
+	rendered, err := renderer.Render(1, "page", true, nil, nil)
+
+	should.NotError(err)
+	should.BeEqual("rendered", rendered)
+	should.MeetExpectations(renderer)
+}
@@ -0,0 +1,5 @@
+// Package mock shares its name with should/mock, to exercise the import aliases of should-mockgen.
+package mock
+
+// Recording is a value from a package named mock.
+type Recording struct{}
@@ -0,0 +1,40 @@
+// Code generated by should-mockgen. DO NOT EDIT.
+
+package example
+
+import (
+	template2 "html/template"
+	"text/template"
+
+	mock2 "github.com/pjbgf/go-test/cmd/should-mockgen/internal/example/mock"
+	"github.com/pjbgf/go-test/should/mock"
+)
+
+// RendererMock is a mock implementation of Renderer.
+type RendererMock struct {
+	mock.Mock
+}
+
+var _ Renderer = (*RendererMock)(nil)
+
+// Funcs records the call and returns the results set with On("Funcs").Return(...).
+func (m *RendererMock) Funcs(p0 string) template.FuncMap {
+	r := m.Called("Funcs", p0)
+	r0, _ := r.Get(0).(template.FuncMap)
+	return r0
+}
+
+// Render records the call and returns the results set with On("Render").Return(...).
+func (m *RendererMock) Render(p0 int, p1_2 string, p1 bool, text *template.Template, html *template2.Template) (string, error) {
+	r := m.Called("Render", p0, p1_2, p1, text, html)
+	r0, _ := r.Get(0).(string)
+	r1, _ := r.Get(1).(error)
+	return r0, r1
+}
+
+// Replay records the call and returns the results set with On("Replay").Return(...).
+func (m *RendererMock) Replay(p0 mock2.Recording) mock2.Recording {
+	r := m.Called("Replay", p0)
+	r0, _ := r.Get(0).(mock2.Recording)
+	return r0
+}
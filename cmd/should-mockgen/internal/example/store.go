@@ -0,0 +1,38 @@
+// Package example declares interfaces used to exercise should-mockgen.
+package example
+
+import (
+	"context"
+	htmltemplate "html/template"
+	"io"
+	"text/template"
+
+	examplemock "github.com/pjbgf/go-test/cmd/should-mockgen/internal/example/mock"
+)
+
+//go:generate go run github.com/pjbgf/go-test/cmd/should-mockgen -interface Store
+//go:generate go run github.com/pjbgf/go-test/cmd/should-mockgen -interface Cache
+//go:generate go run github.com/pjbgf/go-test/cmd/should-mockgen -interface Renderer
+
+// Store is a plain interface with variadic and embedded methods.
+type Store interface {
+	io.Closer
+	Get(ctx context.Context, key string) ([]byte, error)
+	Put(ctx context.Context, key string, value []byte) error
+	Delete(ctx context.Context, keys ...string) (int, error)
+	Logf(format string, _ ...interface{})
+}
+
+// Cache is a generic interface.
+type Cache[K comparable, V any] interface {
+	Get(key K) (V, bool)
+	Set(key K, value V)
+	Keys() []K
+}
+
+// Renderer has parameters and imports whose names clash with the generated code.
+type Renderer interface {
+	Render(r0 int, m string, p1 bool, text *template.Template, html *htmltemplate.Template) (r1 string, err error)
+	Funcs(template string) template.FuncMap
+	Replay(mock examplemock.Recording) examplemock.Recording
+}
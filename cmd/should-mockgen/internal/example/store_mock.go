@@ -0,0 +1,51 @@
+// Code generated by should-mockgen. DO NOT EDIT.
+
+package example
+
+import (
+	"context"
+
+	"github.com/pjbgf/go-test/should/mock"
+)
+
+// StoreMock is a mock implementation of Store.
+type StoreMock struct {
+	mock.Mock
+}
+
+var _ Store = (*StoreMock)(nil)
+
+// Close records the call and returns the results set with On("Close").Return(...).
+func (m *StoreMock) Close() error {
+	r := m.Called("Close")
+	r0, _ := r.Get(0).(error)
+	return r0
+}
+
+// Delete records the call and returns the results set with On("Delete").Return(...).
+func (m *StoreMock) Delete(ctx context.Context, keys ...string) (int, error) {
+	r := m.Called("Delete", ctx, keys)
+	r0, _ := r.Get(0).(int)
+	r1, _ := r.Get(1).(error)
+	return r0, r1
+}
+
+// Get records the call and returns the results set with On("Get").Return(...).
+func (m *StoreMock) Get(ctx context.Context, key string) ([]byte, error) {
+	r := m.Called("Get", ctx, key)
+	r0, _ := r.Get(0).([]byte)
+	r1, _ := r.Get(1).(error)
+	return r0, r1
+}
+
+// Logf records the call.
+func (m *StoreMock) Logf(format string, p1 ...interface{}) {
+	m.Called("Logf", format, p1)
+}
+
+// Put records the call and returns the results set with On("Put").Return(...).
+func (m *StoreMock) Put(ctx context.Context, key string, value []byte) error {
+	r := m.Called("Put", ctx, key, value)
+	r0, _ := r.Get(0).(error)
+	return r0
+}
@@ -0,0 +1,79 @@
+// Command should-mockgen generates mocks of Go interfaces backed by the should/mock package.
+//
+// It is meant to be run through go:generate from the package declaring the interface:
+//
+//	//go:generate go run github.com/pjbgf/go-test/cmd/should-mockgen -interface Store
+//
+// By default the mock is named after the interface with a Mock suffix, written to the
+// package the interface belongs to, and saved as <interface>_mock.go in lower case.
+// Variadic parameters are recorded as a single slice argument.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+)
+
+const generatorName string = "should-mockgen"
+
+func main() {
+	if err := run(os.Args[1:]); err != nil {
+		fmt.Fprintf(os.Stderr, "%s: %v\n", generatorName, err)
+		os.Exit(1)
+	}
+}
+
+func run(args []string) error {
+	flags := flag.NewFlagSet(generatorName, flag.ContinueOnError)
+	source := flags.String("source", ".", "directory or import path of the package declaring the interface")
+	iface := flags.String("interface", "", "name of the interface to mock (required)")
+	mockName := flags.String("mock", "", "name of the generated mock (default <interface>Mock)")
+	pkgName := flags.String("package", "", "package name of the generated file (default the source package)")
+	pkgPath := flags.String("package-path", "", "import path of the generated file's package (default the source package)")
+	output := flags.String("output", "", "output file, or - for stdout (default <interface>_mock.go)")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	if *iface == "" {
+		flags.Usage()
+		return fmt.Errorf("-interface is required")
+	}
+
+	pkg, err := loadPackage(*source)
+	if err != nil {
+		return err
+	}
+
+	opts := options{
+		iface:     *iface,
+		mockName:  *mockName,
+		pkgName:   *pkgName,
+		pkgPath:   *pkgPath,
+		generator: generatorName,
+	}
+	if opts.mockName == "" {
+		opts.mockName = *iface + "Mock"
+	}
+	if opts.pkgName == "" {
+		opts.pkgName = pkg.Name()
+	}
+
+	code, err := generate(pkg, opts)
+	if err != nil {
+		return err
+	}
+
+	switch *output {
+	case "-":
+		_, err = os.Stdout.Write(code)
+		return err
+	case "":
+		*output = strings.ToLower(*iface) + "_mock.go"
+	}
+
+	return ioutil.WriteFile(*output, code, 0600)
+}
@@ -0,0 +1,74 @@
+package main
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestGenerate(t *testing.T) {
+	pkg, err := loadPackage("./internal/example")
+	if err != nil {
+		t.Fatalf("loading example package: %v", err)
+	}
+
+	assertThat := func(assumption string, iface string, golden string) {
+		expected, err := ioutil.ReadFile(filepath.Join("internal", "example", golden))
+		if err != nil {
+			t.Fatalf("%s: reading golden file: %v", assumption, err)
+		}
+
+		actual, err := generate(pkg, options{iface: iface, mockName: iface + "Mock", pkgName: "example", generator: generatorName})
+		if err != nil {
+			t.Errorf("%s: unexpected error %v", assumption, err)
+		}
+		if string(expected) != string(actual) {
+			t.Errorf("%s: wanted '%s' got '%s'", assumption, expected, actual)
+		}
+	}
+
+	assertThat("should generate mocks with variadic and embedded methods", "Store", "store_mock.go")
+	assertThat("should generate mocks of generic interfaces", "Cache", "cache_mock.go")
+	assertThat("should rename clashing parameters and alias clashing imports", "Renderer", "renderer_mock.go")
+}
+
+func TestGenerateForOtherPackage(t *testing.T) {
+	pkg, err := loadPackage("./internal/example")
+	if err != nil {
+		t.Fatalf("loading example package: %v", err)
+	}
+
+	actual, err := generate(pkg, options{iface: "Store", mockName: "Store", pkgName: "mocks", pkgPath: "example.com/mocks", generator: generatorName})
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	for _, expected := range []string{
+		"package mocks\n",
+		"\"github.com/pjbgf/go-test/cmd/should-mockgen/internal/example\"\n",
+		"// Store is a mock implementation of example.Store.\n",
+		"var _ example.Store = (*Store)(nil)\n",
+	} {
+		if !strings.Contains(string(actual), expected) {
+			t.Errorf("wanted output containing '%s' got '%s'", expected, actual)
+		}
+	}
+}
+
+func TestGenerateErrors(t *testing.T) {
+	pkg, err := loadPackage("./internal/example")
+	if err != nil {
+		t.Fatalf("loading example package: %v", err)
+	}
+
+	assertThat := func(assumption string, iface string, expected string) {
+		_, err := generate(pkg, options{iface: iface, mockName: "M", pkgName: "example"})
+		if err == nil || err.Error() != expected {
+			t.Errorf("%s: wanted '%s' got '%v'", assumption, expected, err)
+		}
+	}
+
+	assertThat("should fail for unknown interfaces", "Missing",
+		"Missing not found in github.com/pjbgf/go-test/cmd/should-mockgen/internal/example")
+}
@@ -0,0 +1,160 @@
+package should
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"runtime"
+	"strings"
+	"sync"
+)
+
+const packagePath string = "github.com/pjbgf/go-test/should."
+
+// sources caches the files parsed by callArguments, as every assertion without an
+// assumption reads its caller, and test files usually hold many assertions.
+var sources = struct {
+	sync.Mutex
+	files map[string]*parsedSource
+}{files: make(map[string]*parsedSource)}
+
+// parsedSource is a source file and its syntax tree, which is nil if it could not be parsed.
+type parsedSource struct {
+	fset *token.FileSet
+	file *ast.File
+	src  []byte
+}
+
+// describe returns the assumption supplied by the caller. When none was given,
+// it derives one from the source expression that called method, by rendering
+// the call arguments into template. Template must refer to arguments with
+// explicit indexes, e.g. "%[2]s equals %[1]s".
+func describe(assumption []string, method, template string) string {
+	text := strings.TrimSpace(strings.Join(assumption, " "))
+	if text != "" {
+		return text
+	}
+	if !strings.Contains(template, "%[") {
+		return template
+	}
+
+	file, line, ok := callerLocation()
+	if !ok {
+		return method
+	}
+
+	args, ok := callArguments(file, line, method)
+	if !ok || len(args) < strings.Count(template, "%[") {
+		return method
+	}
+
+	values := make([]interface{}, len(args))
+	for i, arg := range args {
+		values[i] = arg
+	}
+
+	return fmt.Sprintf(template, values...)
+}
+
+// Because returns a Should whose assertions are described by assumption.
+// It is the way to describe assertions whose last parameter is variadic,
+// such as HaveBeenCalledWith; an assumption given to an assertion call takes
+// precedence over it.
+func (s *Should) Because(assumption ...string) *Should {
+	derived := *s
+	derived.because = assumption
+	return &derived
+}
+
+// assumed returns the assumption given to an assertion call, falling back to
+// the one set through Because.
+func (s *Should) assumed(assumption []string) []string {
+	if len(assumption) == 0 {
+		return s.because
+	}
+	return assumption
+}
+
+// callerLocation finds the first frame outside of this package, which is
+// where the assertion was written. Frames from test files are always
+// considered callers, so the package's own tests get derived assumptions too.
+func callerLocation() (file string, line int, ok bool) {
+	pc := make([]uintptr, 32)
+	n := runtime.Callers(3, pc)
+	frames := runtime.CallersFrames(pc[:n])
+
+	for {
+		frame, more := frames.Next()
+		if !strings.HasPrefix(frame.Function, packagePath) ||
+			strings.HasSuffix(frame.File, "_test.go") {
+			return frame.File, frame.Line, frame.File != ""
+		}
+
+		if !more {
+			return "", 0, false
+		}
+	}
+}
+
+// callArguments parses file and returns the source text of the arguments
+// passed to method on line. It fails when several calls to method cover the
+// line, as the one that was made cannot be told apart.
+func callArguments(file string, line int, method string) (args []string, ok bool) {
+	source := parseSource(file)
+	if source.file == nil {
+		return nil, false
+	}
+	fset, f, src := source.fset, source.file, source.src
+
+	var matched *ast.CallExpr
+	ast.Inspect(f, func(n ast.Node) bool {
+		call, isCall := n.(*ast.CallExpr)
+		if !isCall || fset.Position(call.Pos()).Line > line || fset.Position(call.End()).Line < line {
+			return true
+		}
+
+		selector, isSelector := call.Fun.(*ast.SelectorExpr)
+		if !isSelector || selector.Sel.Name != method {
+			return true
+		}
+
+		if matched != nil {
+			ok = false
+			return false
+		}
+		matched, ok = call, true
+		return true
+	})
+	if !ok {
+		return nil, false
+	}
+
+	for _, arg := range matched.Args {
+		start := fset.Position(arg.Pos()).Offset
+		end := fset.Position(arg.End()).Offset
+		args = append(args, string(src[start:end]))
+	}
+	return args, true
+}
+
+// parseSource reads and parses file, once per file.
+func parseSource(file string) *parsedSource {
+	sources.Lock()
+	defer sources.Unlock()
+
+	if source, ok := sources.files[file]; ok {
+		return source
+	}
+
+	source := &parsedSource{fset: token.NewFileSet()}
+	if src, err := ioutil.ReadFile(file); err == nil { // #nosec G304
+		if f, err := parser.ParseFile(source.fset, file, src, 0); err == nil {
+			source.file, source.src = f, src
+		}
+	}
+
+	sources.files[file] = source
+	return source
+}
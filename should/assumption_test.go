@@ -0,0 +1,79 @@
+package should
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDerivedAssumption(t *testing.T) {
+	sum := func(value1, value2 int) int {
+		return value1 + value2
+	}
+
+	assertThat := func(assumption string, stub *testingStub, expected string) {
+		if !stub.hasFailed {
+			t.Errorf("%s: test was expected to fail but did not", assumption)
+		}
+		if !strings.Contains(stub.logMessage, expected) {
+			t.Errorf("%s: wanted log containing '%s' got '%s'", assumption, expected, stub.logMessage)
+		}
+	}
+
+	stub := testingStub{}
+	New(&stub).BeEqual(42, sum(40, 1))
+	assertThat("should derive assumption when none is given", &stub,
+		"assumption: [ sum(40, 1) equals 42 ]")
+
+	stub = testingStub{}
+	New(&stub).BeEqual(42, sum(40, 1), "")
+	assertThat("should derive assumption when an empty one is given", &stub,
+		"assumption: [ sum(40, 1) equals 42 ]")
+
+	stub = testingStub{}
+	New(&stub).BeNotEqual(
+		"abc",
+		strings.ToLower("ABC"))
+	assertThat("should derive assumption for calls spanning multiple lines", &stub,
+		"assumption: [ strings.ToLower(\"ABC\") does not equal \"abc\" ]")
+
+	stub = testingStub{}
+	New(&stub).BeTrue(sum(1, 1) == 3)
+	assertThat("should derive assumption for single value methods", &stub,
+		"assumption: [ sum(1, 1) == 3 is true ]")
+
+	stub = testingStub{}
+	New(&stub).HaveSameItems([]int{1, 2}, []int{2, 3})
+	assertThat("should derive assumption for each failure reason", &stub,
+		"assumption: [ []int{2, 3} has the same items as []int{1, 2} ]")
+
+	stub = testingStub{}
+	func(s *Should) { s.BeEqual(1, 1); s.BeEqual(2, 3) }(New(&stub))
+	assertThat("should not derive assumption from another call on the same line", &stub,
+		"assumption: [ BeEqual ]")
+
+	stub = testingStub{}
+	New(&stub).BeNil("value", "value should be nil")
+	assertThat("should keep supplied assumption", &stub,
+		"assumption: [ value should be nil ]")
+
+	stub = testingStub{}
+	New(&stub).Because("value is nil").BeNil("value")
+	assertThat("should use the assumption set through Because", &stub,
+		"assumption: [ value is nil ]")
+
+	stub = testingStub{}
+	New(&stub).Because("value is nil").BeNil("value", "value should be nil")
+	assertThat("should prefer supplied assumption over Because", &stub,
+		"assumption: [ value should be nil ]")
+}
+
+func TestParseSource(t *testing.T) {
+	first, second := parseSource("assumption_test.go"), parseSource("assumption_test.go")
+	if first.file == nil || first != second {
+		t.Error("wanted the parsed file to be cached")
+	}
+
+	if missing := parseSource("missing_test.go"); missing.file != nil || parseSource("missing_test.go") != missing {
+		t.Error("wanted files that cannot be parsed to be cached without a syntax tree")
+	}
+}
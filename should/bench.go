@@ -0,0 +1,188 @@
+package should
+
+import (
+	"fmt"
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+const (
+	distributionLogFormat string = "\nassumption: [ %s ]\n    should: %s \n  expected: %v\n    actual: %v\n      runs: %d\n       min: %v\n    median: %v\n       p95: %v\n       max: %v"
+
+	// allocationRuns is how many times AllocateAtMost runs fn to average its allocations.
+	allocationRuns int = 100
+
+	// UpdateEnv is the environment variable that, when set to a non-empty value,
+	// makes assertions against stored files record the actual values instead.
+	UpdateEnv string = "SHOULD_UPDATE"
+)
+
+// baselineMetrics are the benchmark metrics compared against a baseline, in report order.
+var baselineMetrics = []string{"ns/op", "B/op", "allocs/op"}
+
+// measure returns how long fn takes to run.
+var measure = func(fn func()) time.Duration {
+	start := time.Now()
+	fn()
+	return time.Since(start)
+}
+
+// AllocateAtMost fails the test if fn allocates on average more than n times per run.
+func (s *Should) AllocateAtMost(n int, fn func(), assumption ...string) {
+	defer s.track("AllocateAtMost", assumption)()
+
+	if allocs := testing.AllocsPerRun(allocationRuns, fn); allocs > float64(n) {
+		s.t.Helper()
+		s.t.Log(fmt.Sprintf(valuesLogFormat, describe(s.assumed(assumption), "AllocateAtMost", "%[2]s allocates at most %[1]s times"), "AllocateAtMost",
+			fmt.Sprintf("at most %d allocs/run", n), fmt.Sprintf("%v allocs/run", allocs)))
+		s.t.Fail()
+	}
+}
+
+// RunWithin runs fn iterations times, after a warm-up run, and fails the test if the 95th percentile
+// of its durations exceeds budget. The failure reports the distribution of the durations.
+func (s *Should) RunWithin(budget time.Duration, fn func(), iterations int, assumption ...string) {
+	defer s.track("RunWithin", assumption)()
+
+	if iterations < 1 {
+		iterations = 1
+	}
+
+	fn()
+	durations := make([]time.Duration, iterations)
+	for i := range durations {
+		durations[i] = measure(fn)
+	}
+	sort.Slice(durations, func(i, j int) bool { return durations[i] < durations[j] })
+
+	p95 := percentile(durations, 95)
+	if p95 > budget {
+		s.t.Helper()
+		s.t.Log(fmt.Sprintf(distributionLogFormat, describe(s.assumed(assumption), "RunWithin", "%[2]s runs within %[1]s"), "RunWithin",
+			fmt.Sprintf("p95 <= %v", budget), fmt.Sprintf("p95 = %v", p95),
+			iterations, durations[0], median(durations), p95, durations[len(durations)-1]))
+		s.t.Fail()
+	}
+}
+
+// median returns the median of sorted durations.
+func median(sorted []time.Duration) time.Duration {
+	n := len(sorted)
+	if n%2 == 1 {
+		return sorted[n/2]
+	}
+	return (sorted[n/2-1] + sorted[n/2]) / 2
+}
+
+// percentile returns the p-th percentile of sorted durations, using the nearest-rank method.
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
+
+// MatchBenchmarkBaseline fails the test if result is slower, or allocates more, than the baseline stored
+// at path by more than tolerance, a fraction such as 0.1 for 10%. Only metrics present in both are compared.
+// The baseline uses the format of go test -bench output, without the benchmark name, and is
+// written from result when the SHOULD_UPDATE environment variable is set.
+func (s *Should) MatchBenchmarkBaseline(path string, result testing.BenchmarkResult, tolerance float64, assumption ...string) {
+	defer s.track("MatchBenchmarkBaseline", assumption)()
+
+	current := result.String() + "\t" + result.MemString()
+
+	if os.Getenv(UpdateEnv) != "" {
+		if err := writeFile(path, current+"\n"); err != nil {
+			s.t.Helper()
+			s.t.Log(fmt.Sprintf(pathReasonLogFormat, describe(s.assumed(assumption), "MatchBenchmarkBaseline", "benchmark matches %[1]s"), "MatchBenchmarkBaseline",
+				err.Error(), path, "baseline written", nil))
+			s.t.Fail()
+		}
+		return
+	}
+
+	content, err := ioutil.ReadFile(path) // #nosec G304
+	if err != nil {
+		s.t.Helper()
+		s.t.Log(fmt.Sprintf(pathReasonLogFormat, describe(s.assumed(assumption), "MatchBenchmarkBaseline", "benchmark matches %[1]s"), "MatchBenchmarkBaseline",
+			fmt.Sprintf("%v (set %s=1 to record it)", err, UpdateEnv), path, "baseline", nil))
+		s.t.Fail()
+		return
+	}
+
+	baselineText := strings.TrimSpace(string(content))
+	baseline, err := parseBenchmarkLine(baselineText)
+	if err != nil {
+		s.t.Helper()
+		s.t.Log(fmt.Sprintf(pathReasonLogFormat, describe(s.assumed(assumption), "MatchBenchmarkBaseline", "benchmark matches %[1]s"), "MatchBenchmarkBaseline",
+			"invalid baseline: "+err.Error(), path, "baseline", escape(baselineText)))
+		s.t.Fail()
+		return
+	}
+
+	actual, _ := parseBenchmarkLine(current)
+	var regressions []string
+	for _, metric := range baselineMetrics {
+		before, ok := baseline[metric]
+		after, found := actual[metric]
+		if !ok || !found || after <= before*(1+tolerance) {
+			continue
+		}
+
+		change := "+Inf%"
+		if before > 0 {
+			change = fmt.Sprintf("%+.1f%%", (after-before)/before*100)
+		}
+		regressions = append(regressions, fmt.Sprintf("%s: %v, baseline %v (%s)", metric, after, before, change))
+	}
+
+	if len(regressions) > 0 {
+		s.t.Helper()
+		s.t.Log(fmt.Sprintf(differencesLogFormat, describe(s.assumed(assumption), "MatchBenchmarkBaseline", "benchmark matches %[1]s"), "MatchBenchmarkBaseline",
+			fmt.Sprintf("regressed by more than %v%%", tolerance*100), strings.Join(strings.Fields(baselineText), " "),
+			strings.Join(strings.Fields(current), " "), strings.Join(regressions, differencesIndent)))
+		s.t.Fail()
+	}
+}
+
+// parseBenchmarkLine reads the metrics of a benchmark line, such as "1000  1234 ns/op  16 B/op",
+// optionally preceded by the benchmark name.
+func parseBenchmarkLine(line string) (map[string]float64, error) {
+	fields := strings.Fields(line)
+	if len(fields) > 0 && strings.HasPrefix(fields[0], "Benchmark") {
+		fields = fields[1:]
+	}
+	if len(fields) == 0 || len(fields)%2 == 0 {
+		return nil, fmt.Errorf("expected iterations followed by value and unit pairs")
+	}
+	if _, err := strconv.Atoi(fields[0]); err != nil {
+		return nil, fmt.Errorf("invalid iterations %q", fields[0])
+	}
+
+	metrics := make(map[string]float64)
+	for i := 1; i < len(fields); i += 2 {
+		value, err := strconv.ParseFloat(fields[i], 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value %q for %s", fields[i], fields[i+1])
+		}
+		metrics[fields[i+1]] = value
+	}
+	return metrics, nil
+}
+
+// writeFile writes content to path, creating its directory if needed.
+func writeFile(path, content string) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0750); err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(path, []byte(content), 0600)
+}
@@ -0,0 +1,199 @@
+package should
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+var allocSink []byte
+
+func allocate() {
+	allocSink = make([]byte, 64)
+}
+
+func noop() {}
+
+func TestAllocateAtMost(t *testing.T) {
+	t.Run("scenarios that must fail tests", func(t *testing.T) {
+		stub := testingStub{}
+		should := New(&stub)
+		expectedLogMessage := "\nassumption: [ allocate allocates at most 0 times ]\n    should: AllocateAtMost " +
+			"\n  expected: at most 0 allocs/run\n    actual: 1 allocs/run"
+
+		should.AllocateAtMost(0, allocate)
+
+		if !stub.hasFailed {
+			t.Error("test was expected to fail but did not")
+		}
+		if !stub.WasHelperCalled() {
+			t.Error("Helper() call was expected but did not happen")
+		}
+		if expectedLogMessage != stub.logMessage {
+			t.Errorf("wanted '%s' got '%s'", expectedLogMessage, stub.logMessage)
+		}
+	})
+
+	t.Run("scenarios that must not fail tests", func(t *testing.T) {
+		assertThat := func(assumption string, n int, fn func()) {
+			stub := testingStub{}
+			should := New(&stub)
+
+			should.AllocateAtMost(n, fn, assumption)
+
+			if stub.hasFailed {
+				t.Error("test was expected to not fail but it did")
+			}
+			if stub.WasHelperCalled() {
+				t.Error("Helper() call was not expected")
+			}
+			if stub.logMessage != "" {
+				t.Errorf("wanted '%s' got '%s'", "", stub.logMessage)
+			}
+		}
+
+		assertThat("should not fail when fn does not allocate", 0, noop)
+		assertThat("should not fail within the allocation budget", 1, allocate)
+	})
+}
+
+func TestRunWithin(t *testing.T) {
+	defer func(original func(func()) time.Duration) { measure = original }(measure)
+
+	durations := func(ms ...int) {
+		measure = func(fn func()) time.Duration {
+			fn()
+			d := time.Duration(ms[0]) * time.Millisecond
+			ms = ms[1:]
+			return d
+		}
+	}
+
+	t.Run("scenarios that must fail tests", func(t *testing.T) {
+		assertThat := func(assumption string, budget time.Duration, runs []int, expectedLogMessage string) {
+			stub := testingStub{}
+			should := New(&stub)
+			durations(runs...)
+
+			should.RunWithin(budget, noop, len(runs), assumption)
+
+			if !stub.hasFailed {
+				t.Error("test was expected to fail but did not")
+			}
+			if !stub.WasHelperCalled() {
+				t.Error("Helper() call was expected but did not happen")
+			}
+			if expectedLogMessage != stub.logMessage {
+				t.Errorf("wanted '%s' got '%s'", expectedLogMessage, stub.logMessage)
+			}
+		}
+
+		assertThat("should fail when p95 exceeds the budget", 10*time.Millisecond, []int{5, 3, 12, 4},
+			"\nassumption: [ should fail when p95 exceeds the budget ]\n    should: RunWithin \n  expected: p95 <= 10ms\n    actual: p95 = 12ms"+
+				"\n      runs: 4\n       min: 3ms\n    median: 4.5ms\n       p95: 12ms\n       max: 12ms")
+		assertThat("should fail for a single slow run", time.Millisecond, []int{2},
+			"\nassumption: [ should fail for a single slow run ]\n    should: RunWithin \n  expected: p95 <= 1ms\n    actual: p95 = 2ms"+
+				"\n      runs: 1\n       min: 2ms\n    median: 2ms\n       p95: 2ms\n       max: 2ms")
+	})
+
+	t.Run("scenarios that must not fail tests", func(t *testing.T) {
+		stub := testingStub{}
+		should := New(&stub)
+		runs := make([]int, 20)
+		for i := range runs {
+			runs[i] = 1
+		}
+		runs[7] = 50
+		durations(runs...)
+
+		should.RunWithin(10*time.Millisecond, noop, len(runs), "should not fail for outliers beyond p95")
+
+		if stub.hasFailed || stub.WasHelperCalled() || stub.logMessage != "" {
+			t.Errorf("test was expected to not fail but it did: '%s'", stub.logMessage)
+		}
+	})
+}
+
+func TestMatchBenchmarkBaseline(t *testing.T) {
+	dir := t.TempDir()
+	baseline := func(name, content string) string {
+		path := filepath.Join(dir, name)
+		if err := ioutil.WriteFile(path, []byte(content), 0600); err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+	result := testing.BenchmarkResult{N: 1000, T: 1200 * time.Microsecond, MemAllocs: 2000, MemBytes: 32000}
+
+	t.Run("scenarios that must fail tests", func(t *testing.T) {
+		assertThat := func(assumption, path string, expectedLogMessage string) {
+			stub := testingStub{}
+			should := New(&stub)
+
+			should.MatchBenchmarkBaseline(path, result, 0.1, assumption)
+
+			if !stub.hasFailed {
+				t.Error("test was expected to fail but did not")
+			}
+			if !stub.WasHelperCalled() {
+				t.Error("Helper() call was expected but did not happen")
+			}
+			if expectedLogMessage != strings.ReplaceAll(stub.logMessage, dir, "<dir>") {
+				t.Errorf("wanted '%s' got '%s'", expectedLogMessage, stub.logMessage)
+			}
+		}
+
+		assertThat("should fail for regressions beyond the tolerance",
+			baseline("slower.txt", "BenchmarkSum\t1000\t1000 ns/op\t16 B/op\t2 allocs/op\n"),
+			"\nassumption: [ should fail for regressions beyond the tolerance ]\n    should: MatchBenchmarkBaseline \n    reason: regressed by more than 10%"+
+				"\n  expected: BenchmarkSum 1000 1000 ns/op 16 B/op 2 allocs/op\n    actual: 1000 1200 ns/op 32 B/op 2 allocs/op"+
+				"\n   differs: ns/op: 1200, baseline 1000 (+20.0%)"+
+				"\n            B/op: 32, baseline 16 (+100.0%)")
+		assertThat("should fail for new allocations",
+			baseline("allocs.txt", "1000\t1150 ns/op\t0 allocs/op\n"),
+			"\nassumption: [ should fail for new allocations ]\n    should: MatchBenchmarkBaseline \n    reason: regressed by more than 10%"+
+				"\n  expected: 1000 1150 ns/op 0 allocs/op\n    actual: 1000 1200 ns/op 32 B/op 2 allocs/op"+
+				"\n   differs: allocs/op: 2, baseline 0 (+Inf%)")
+		assertThat("should fail for invalid baselines",
+			baseline("invalid.txt", "1000\tfast ns/op\n"),
+			"\nassumption: [ should fail for invalid baselines ]\n    should: MatchBenchmarkBaseline \n    reason: invalid baseline: invalid value \"fast\" for ns/op"+
+				"\n      path: <dir>/invalid.txt\n  expected: baseline\n    actual: 1000\\tfast ns/op")
+		assertThat("should fail for missing baselines",
+			filepath.Join(dir, "missing.txt"),
+			"\nassumption: [ should fail for missing baselines ]\n    should: MatchBenchmarkBaseline \n"+
+				"    reason: open <dir>/missing.txt: no such file or directory (set SHOULD_UPDATE=1 to record it)"+
+				"\n      path: <dir>/missing.txt\n  expected: baseline\n    actual: <nil>")
+	})
+
+	t.Run("scenarios that must not fail tests", func(t *testing.T) {
+		assertThat := func(assumption, path string) {
+			stub := testingStub{}
+			should := New(&stub)
+
+			should.MatchBenchmarkBaseline(path, result, 0.1, assumption)
+
+			if stub.hasFailed {
+				t.Error("test was expected to not fail but it did")
+			}
+			if stub.WasHelperCalled() {
+				t.Error("Helper() call was not expected")
+			}
+			if stub.logMessage != "" {
+				t.Errorf("wanted '%s' got '%s'", "", stub.logMessage)
+			}
+		}
+
+		assertThat("should not fail within the tolerance", baseline("within.txt", "1000\t1100 ns/op\t32 B/op\t2 allocs/op\n"))
+		assertThat("should not fail for improvements", baseline("faster.txt", "500\t2000 ns/op\t64 B/op\t4 allocs/op\n"))
+		assertThat("should only compare metrics in the baseline", baseline("partial.txt", "1000\t1200 ns/op\n"))
+
+		recorded := filepath.Join(dir, "recorded", "sum.txt")
+		t.Run("updating", func(t *testing.T) {
+			t.Setenv(UpdateEnv, "1")
+			assertThat("should record the baseline when updating", recorded)
+		})
+		assertThat("should match the recorded baseline", recorded)
+	})
+}
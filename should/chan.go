@@ -0,0 +1,223 @@
+package should
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+)
+
+const channelLogFormat string = "\nassumption: [ %s ]\n    should: %s \n    reason: %s\n  expected: %v\n    actual: %v\n    buffer: %d/%d"
+
+// Receive fails the test if no value is received from ch within timeout, and returns the received value.
+// Ch can be any channel that allows receiving.
+func (s *Should) Receive(ch interface{}, timeout time.Duration, assumption ...string) interface{} {
+	defer s.track("Receive", assumption)()
+
+	channel, reason := channelValue(ch, reflect.RecvDir)
+	if reason == "" {
+		value, received, reasonReceive := receive(s.context(), channel, timeout)
+		if received {
+			return value.Interface()
+		}
+		reason = reasonReceive
+	}
+
+	s.t.Helper()
+	s.t.Log(fmt.Sprintf(channelLogFormat, describe(s.assumed(assumption), "Receive", "%[1]s receives a value within %[2]s"), "Receive",
+		reason, "a value", nil, channelLen(channel), channelCap(channel)))
+	s.t.Fail()
+	return nil
+}
+
+// ReceiveValue fails the test if expected is not the next value received from ch within timeout.
+func (s *Should) ReceiveValue(ch interface{}, expected interface{}, timeout time.Duration, assumption ...string) {
+	defer s.track("ReceiveValue", assumption)()
+
+	channel, reason := channelValue(ch, reflect.RecvDir)
+	var actual interface{}
+	if reason == "" {
+		value, received, reasonReceive := receive(s.context(), channel, timeout)
+		if received && reflect.DeepEqual(expected, value.Interface()) {
+			return
+		}
+
+		reason = reasonReceive
+		if received {
+			reason, actual = "received a different value", escape(value.Interface())
+		}
+	}
+
+	s.t.Helper()
+	s.t.Log(fmt.Sprintf(channelLogFormat, describe(s.assumed(assumption), "ReceiveValue", "%[1]s receives %[2]s within %[3]s"), "ReceiveValue",
+		reason, escape(expected), actual, channelLen(channel), channelCap(channel)))
+	s.t.Fail()
+}
+
+// NotReceive fails the test if a value is received from ch within the given duration.
+// A channel that is closed, and therefore yields no value, does not fail the test.
+func (s *Should) NotReceive(ch interface{}, within time.Duration, assumption ...string) {
+	defer s.track("NotReceive", assumption)()
+
+	channel, reason := channelValue(ch, reflect.RecvDir)
+	var actual interface{}
+	if reason == "" {
+		value, received, _ := receive(s.context(), channel, within)
+		if !received {
+			return
+		}
+		reason, actual = "received a value", escape(value.Interface())
+	}
+
+	s.t.Helper()
+	s.t.Log(fmt.Sprintf(channelLogFormat, describe(s.assumed(assumption), "NotReceive", "%[1]s receives nothing within %[2]s"), "NotReceive",
+		reason, "no value", actual, channelLen(channel), channelCap(channel)))
+	s.t.Fail()
+}
+
+// BeClosed fails the test if ch is not closed. Values still buffered in ch are drained first,
+// so a closed channel passes regardless of what was left in it.
+func (s *Should) BeClosed(ch interface{}, assumption ...string) {
+	defer s.track("BeClosed", assumption)()
+
+	channel, reason := channelValue(ch, reflect.RecvDir)
+	length, capacity := channelLen(channel), channelCap(channel)
+	if reason == "" {
+		drained := 0
+		for {
+			_, ok, blocked := tryReceive(channel)
+			if blocked {
+				reason = fmt.Sprintf("channel is open (%d buffered values drained)", drained)
+				break
+			}
+			if !ok {
+				return
+			}
+			drained++
+		}
+	}
+
+	s.t.Helper()
+	s.t.Log(fmt.Sprintf(channelLogFormat, describe(s.assumed(assumption), "BeClosed", "%[1]s is closed"), "BeClosed",
+		reason, "closed", "open", length, capacity))
+	s.t.Fail()
+}
+
+// BeSent fails the test if value cannot be sent to ch within timeout.
+func (s *Should) BeSent(ch interface{}, value interface{}, timeout time.Duration, assumption ...string) {
+	defer s.track("BeSent", assumption)()
+
+	channel, reason := channelValue(ch, reflect.SendDir)
+	if reason == "" {
+		if reason = send(s.context(), channel, value, timeout); reason == "" {
+			return
+		}
+	}
+
+	s.t.Helper()
+	s.t.Log(fmt.Sprintf(channelLogFormat, describe(s.assumed(assumption), "BeSent", "%[2]s is sent to %[1]s within %[3]s"), "BeSent",
+		reason, escape(value), nil, channelLen(channel), channelCap(channel)))
+	s.t.Fail()
+}
+
+// channelValue returns ch as a reflect.Value, or the reason why it is not a
+// channel that can be used in direction dir.
+func channelValue(ch interface{}, dir reflect.ChanDir) (reflect.Value, string) {
+	channel := reflect.ValueOf(ch)
+	if channel.Kind() != reflect.Chan {
+		return channel, fmt.Sprintf("%T is not a channel", ch)
+	}
+	if channel.Type().ChanDir()&dir == 0 {
+		return channel, fmt.Sprintf("%s does not allow this direction", channel.Type())
+	}
+	if channel.IsNil() {
+		return channel, "channel is nil"
+	}
+
+	return channel, ""
+}
+
+// receive waits up to timeout for a value from channel, or until ctx is done.
+func receive(ctx context.Context, channel reflect.Value, timeout time.Duration) (value reflect.Value, received bool, reason string) {
+	started := contextClock()
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	chosen, value, ok := reflect.Select([]reflect.SelectCase{
+		{Dir: reflect.SelectRecv, Chan: channel},
+		{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(timer.C)},
+		{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(ctx.Done())},
+	})
+
+	switch {
+	case chosen == 1:
+		return value, false, fmt.Sprintf("timed out after %v", timeout)
+	case chosen == 2:
+		return value, false, contextDone(ctx, started, timeout)
+	case !ok:
+		return value, false, "channel closed"
+	}
+
+	return value, true, ""
+}
+
+func tryReceive(channel reflect.Value) (value reflect.Value, ok bool, blocked bool) {
+	chosen, value, ok := reflect.Select([]reflect.SelectCase{
+		{Dir: reflect.SelectRecv, Chan: channel},
+		{Dir: reflect.SelectDefault},
+	})
+
+	return value, ok, chosen == 1
+}
+
+// send waits up to timeout for value to be sent to channel, or until ctx is done.
+func send(ctx context.Context, channel reflect.Value, value interface{}, timeout time.Duration) (reason string) {
+	elemType := channel.Type().Elem()
+	sendValue := reflect.Zero(elemType)
+	if value != nil {
+		sendValue = reflect.ValueOf(value)
+		if !sendValue.Type().AssignableTo(elemType) {
+			return fmt.Sprintf("%T is not assignable to %s", value, elemType)
+		}
+	}
+
+	defer func() {
+		if recover() != nil {
+			reason = "channel closed"
+		}
+	}()
+
+	started := contextClock()
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	chosen, _, _ := reflect.Select([]reflect.SelectCase{
+		{Dir: reflect.SelectSend, Chan: channel, Send: sendValue},
+		{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(timer.C)},
+		{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(ctx.Done())},
+	})
+	switch chosen {
+	case 1:
+		return fmt.Sprintf("timed out after %v", timeout)
+	case 2:
+		return contextDone(ctx, started, timeout)
+	}
+
+	return ""
+}
+
+func channelLen(channel reflect.Value) int {
+	if channel.Kind() != reflect.Chan {
+		return 0
+	}
+
+	return channel.Len()
+}
+
+func channelCap(channel reflect.Value) int {
+	if channel.Kind() != reflect.Chan {
+		return 0
+	}
+
+	return channel.Cap()
+}
@@ -0,0 +1,104 @@
+package should
+
+import (
+	"testing"
+	"time"
+)
+
+func TestChannel(t *testing.T) {
+	buffered := func(values ...int) chan int {
+		ch := make(chan int, 3)
+		for _, v := range values {
+			ch <- v
+		}
+		return ch
+	}
+	closed := func(values ...int) chan int {
+		ch := buffered(values...)
+		close(ch)
+		return ch
+	}
+
+	t.Run("scenarios that must fail tests", func(t *testing.T) {
+		assertThat := func(assumption string, check func(*Should), expectedLogMessage string) {
+			stub := testingStub{}
+			should := New(&stub)
+
+			check(should)
+
+			if !stub.hasFailed {
+				t.Error("test was expected to fail but did not")
+			}
+			if !stub.WasHelperCalled() {
+				t.Error("Helper() call was expected but did not happen")
+			}
+			if expectedLogMessage != stub.logMessage {
+				t.Errorf("wanted '%s' got '%s'", expectedLogMessage, stub.logMessage)
+			}
+		}
+
+		assertThat("should fail when nothing is received",
+			func(s *Should) { s.Receive(buffered(), time.Millisecond, "receives") },
+			"\nassumption: [ receives ]\n    should: Receive \n    reason: timed out after 1ms\n  expected: a value\n    actual: <nil>\n    buffer: 0/3")
+		assertThat("should fail for values that are not channels",
+			func(s *Should) { s.Receive(42, time.Millisecond, "receives") },
+			"\nassumption: [ receives ]\n    should: Receive \n    reason: int is not a channel\n  expected: a value\n    actual: <nil>\n    buffer: 0/0")
+		assertThat("should fail for send-only channels",
+			func(s *Should) { s.Receive((chan<- int)(buffered()), time.Millisecond, "receives") },
+			"\nassumption: [ receives ]\n    should: Receive \n    reason: chan<- int does not allow this direction\n  expected: a value\n    actual: <nil>\n    buffer: 0/3")
+		assertThat("should fail when a different value is received",
+			func(s *Should) { s.ReceiveValue(buffered(1, 2), 2, time.Millisecond, "receives 2") },
+			"\nassumption: [ receives 2 ]\n    should: ReceiveValue \n    reason: received a different value\n  expected: 2\n    actual: 1\n    buffer: 1/3")
+		assertThat("should fail when the channel is closed",
+			func(s *Should) { s.ReceiveValue(closed(), 2, time.Millisecond, "receives 2") },
+			"\nassumption: [ receives 2 ]\n    should: ReceiveValue \n    reason: channel closed\n  expected: 2\n    actual: <nil>\n    buffer: 0/3")
+		assertThat("should fail when a value is received",
+			func(s *Should) { s.NotReceive(buffered(7), time.Millisecond, "stays quiet") },
+			"\nassumption: [ stays quiet ]\n    should: NotReceive \n    reason: received a value\n  expected: no value\n    actual: 7\n    buffer: 0/3")
+		assertThat("should fail for open channels after draining",
+			func(s *Should) { s.BeClosed(buffered(1, 2), "is closed") },
+			"\nassumption: [ is closed ]\n    should: BeClosed \n    reason: channel is open (2 buffered values drained)\n  expected: closed\n    actual: open\n    buffer: 2/3")
+		assertThat("should fail when sending times out",
+			func(s *Should) { s.BeSent(buffered(1, 2, 3), 4, time.Millisecond, "is sent") },
+			"\nassumption: [ is sent ]\n    should: BeSent \n    reason: timed out after 1ms\n  expected: 4\n    actual: <nil>\n    buffer: 3/3")
+		assertThat("should fail when sending to a closed channel",
+			func(s *Should) { s.BeSent(closed(), 4, time.Millisecond, "is sent") },
+			"\nassumption: [ is sent ]\n    should: BeSent \n    reason: channel closed\n  expected: 4\n    actual: <nil>\n    buffer: 0/3")
+		assertThat("should fail when sending a value of the wrong type",
+			func(s *Should) { s.BeSent(buffered(), "4", time.Millisecond, "is sent") },
+			"\nassumption: [ is sent ]\n    should: BeSent \n    reason: string is not assignable to int\n  expected: 4\n    actual: <nil>\n    buffer: 0/3")
+	})
+
+	t.Run("scenarios that must not fail tests", func(t *testing.T) {
+		assertThat := func(assumption string, check func(*Should)) {
+			stub := testingStub{}
+			should := New(&stub)
+
+			check(should)
+
+			if stub.hasFailed {
+				t.Error("test was expected to not fail but it did")
+			}
+			if stub.WasHelperCalled() {
+				t.Error("Helper() call was not expected")
+			}
+			if stub.logMessage != "" {
+				t.Errorf("wanted '%s' got '%s'", "", stub.logMessage)
+			}
+		}
+
+		assertThat("should not fail when a value is received", func(s *Should) {
+			ch := make(chan string)
+			go func() { ch <- "done" }()
+			if value := s.Receive(ch, time.Second); value != "done" {
+				t.Errorf("wanted '%s' got '%v'", "done", value)
+			}
+		})
+		assertThat("should not fail when the expected value is received", func(s *Should) { s.ReceiveValue((<-chan int)(buffered(3)), 3, time.Second) })
+		assertThat("should not fail when nothing is received", func(s *Should) { s.NotReceive(buffered(), time.Millisecond) })
+		assertThat("should not fail for closed channels", func(s *Should) { s.NotReceive(closed(), time.Millisecond) })
+		assertThat("should not fail for drained closed channels", func(s *Should) { s.BeClosed(closed(1, 2)) })
+		assertThat("should not fail when sending", func(s *Should) { s.BeSent(buffered(1), 2, time.Second) })
+		assertThat("should not fail when sending nil", func(s *Should) { s.BeSent(make(chan error, 1), nil, time.Second) })
+	})
+}
@@ -0,0 +1,96 @@
+package should
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+const contextLogFormat string = "\nassumption: [ %s ]\n    should: %s \n  expected: %v\n    actual: %v\n       err: %v\n remaining: %s"
+
+// contextClock provides the current time when working out how long a context has left.
+var contextClock = time.Now
+
+// WithContext returns a copy of s whose polling assertions stop waiting once ctx is done.
+// Receive, ReceiveValue and BeSent then fail with the context error instead of waiting
+// for their own timeout, while NotReceive stops watching the channel.
+func (s *Should) WithContext(ctx context.Context) *Should {
+	derived := *s
+	derived.ctx = ctx
+	return &derived
+}
+
+// context returns the context bounding the polling assertions of s.
+func (s *Should) context() context.Context {
+	if s.ctx == nil {
+		return context.Background()
+	}
+	return s.ctx
+}
+
+// BeCanceled fails the test if ctx is not done, either by being canceled or by exceeding its deadline.
+func (s *Should) BeCanceled(ctx context.Context, assumption ...string) {
+	defer s.track("BeCanceled", assumption)()
+
+	if err := ctx.Err(); err == nil {
+		s.t.Helper()
+		s.t.Log(fmt.Sprintf(contextLogFormat, describe(s.assumed(assumption), "BeCanceled", "%[1]s is canceled"), "BeCanceled",
+			"canceled", "not canceled", err, remaining(ctx)))
+		s.t.Fail()
+	}
+}
+
+// HaveDeadlineWithin fails the test if ctx has no deadline, or if its deadline is further away than d.
+func (s *Should) HaveDeadlineWithin(ctx context.Context, d time.Duration, assumption ...string) {
+	defer s.track("HaveDeadlineWithin", assumption)()
+
+	deadline, ok := ctx.Deadline()
+	if ok && deadline.Sub(contextClock()) <= d {
+		return
+	}
+
+	actual := "no deadline"
+	if ok {
+		actual = "deadline in " + deadline.Sub(contextClock()).String()
+	}
+
+	s.t.Helper()
+	s.t.Log(fmt.Sprintf(contextLogFormat, describe(s.assumed(assumption), "HaveDeadlineWithin", "%[1]s has a deadline within %[2]s"), "HaveDeadlineWithin",
+		fmt.Sprintf("deadline within %v", d), actual, ctx.Err(), remaining(ctx)))
+	s.t.Fail()
+}
+
+// ErrorIsContextCanceled fails the test if err is not, and does not wrap, context.Canceled.
+// The failure reports the state of the context given to WithContext, if any.
+func (s *Should) ErrorIsContextCanceled(err error, assumption ...string) {
+	defer s.track("ErrorIsContextCanceled", assumption)()
+
+	if !errors.Is(err, context.Canceled) {
+		ctx := s.context()
+
+		s.t.Helper()
+		s.t.Log(fmt.Sprintf(contextLogFormat, describe(s.assumed(assumption), "ErrorIsContextCanceled", "%[1]s is context.Canceled"), "ErrorIsContextCanceled",
+			context.Canceled, err, ctx.Err(), remaining(ctx)))
+		s.t.Fail()
+	}
+}
+
+// remaining describes how long ctx has until its deadline, e.g. "1.5s" or "expired 2s ago".
+func remaining(ctx context.Context) string {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return "no deadline"
+	}
+
+	left := deadline.Sub(contextClock())
+	if left > 0 {
+		return left.String()
+	}
+	return fmt.Sprintf("expired %v ago", -left)
+}
+
+// contextDone describes why waiting for timeout stopped early, after started, because ctx was done.
+func contextDone(ctx context.Context, started time.Time, timeout time.Duration) string {
+	return fmt.Sprintf("%v with %v of the timeout remaining", ctx.Err(), timeout-contextClock().Sub(started))
+}
@@ -0,0 +1,112 @@
+package should
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestContext(t *testing.T) {
+	now := time.Now()
+	defer func(original func() time.Time) { contextClock = original }(contextClock)
+	contextClock = func() time.Time { return now }
+
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+	expiring, cancelExpiring := context.WithDeadline(context.Background(), now.Add(90*time.Second))
+	defer cancelExpiring()
+	expired, cancelExpired := context.WithDeadline(context.Background(), now.Add(-time.Second))
+	defer cancelExpired()
+
+	t.Run("scenarios that must fail tests", func(t *testing.T) {
+		assertThat := func(assumption string, check func(*Should), expectedLogMessage string) {
+			stub := testingStub{}
+			should := New(&stub)
+
+			check(should)
+
+			if !stub.hasFailed {
+				t.Error("test was expected to fail but did not")
+			}
+			if !stub.WasHelperCalled() {
+				t.Error("Helper() call was expected but did not happen")
+			}
+			if expectedLogMessage != stub.logMessage {
+				t.Errorf("wanted '%s' got '%s'", expectedLogMessage, stub.logMessage)
+			}
+		}
+
+		assertThat("should fail when the context is not canceled",
+			func(s *Should) { s.BeCanceled(expiring, "is canceled") },
+			"\nassumption: [ is canceled ]\n    should: BeCanceled \n  expected: canceled\n    actual: not canceled\n       err: <nil>\n remaining: 1m30s")
+		assertThat("should fail when the context has no deadline",
+			func(s *Should) { s.HaveDeadlineWithin(canceled, time.Minute, "has a deadline") },
+			"\nassumption: [ has a deadline ]\n    should: HaveDeadlineWithin \n  expected: deadline within 1m0s\n    actual: no deadline\n       err: context canceled\n remaining: no deadline")
+		assertThat("should fail when the deadline is further away",
+			func(s *Should) { s.HaveDeadlineWithin(expiring, time.Minute, "has a deadline") },
+			"\nassumption: [ has a deadline ]\n    should: HaveDeadlineWithin \n  expected: deadline within 1m0s\n    actual: deadline in 1m30s\n       err: <nil>\n remaining: 1m30s")
+		assertThat("should fail for other errors",
+			func(s *Should) { s.ErrorIsContextCanceled(context.DeadlineExceeded, "is canceled") },
+			"\nassumption: [ is canceled ]\n    should: ErrorIsContextCanceled \n  expected: context canceled\n    actual: context deadline exceeded\n       err: <nil>\n remaining: no deadline")
+		assertThat("should report the context given to WithContext",
+			func(s *Should) { s.WithContext(expired).ErrorIsContextCanceled(nil, "is canceled") },
+			"\nassumption: [ is canceled ]\n    should: ErrorIsContextCanceled \n  expected: context canceled\n    actual: <nil>\n       err: context deadline exceeded\n remaining: expired 1s ago")
+		assertThat("should stop receiving once the context is canceled",
+			func(s *Should) { s.WithContext(canceled).Receive(make(chan int), time.Hour, "receives") },
+			"\nassumption: [ receives ]\n    should: Receive \n    reason: context canceled with 1h0m0s of the timeout remaining\n  expected: a value\n    actual: <nil>\n    buffer: 0/0")
+		assertThat("should stop receiving once the deadline is exceeded",
+			func(s *Should) { s.WithContext(expired).ReceiveValue(make(chan int, 1), 1, time.Hour, "receives 1") },
+			"\nassumption: [ receives 1 ]\n    should: ReceiveValue \n    reason: context deadline exceeded with 1h0m0s of the timeout remaining\n  expected: 1\n    actual: <nil>\n    buffer: 0/1")
+		assertThat("should stop sending once the context is canceled",
+			func(s *Should) { s.WithContext(canceled).BeSent(make(chan int), 1, time.Hour, "is sent") },
+			"\nassumption: [ is sent ]\n    should: BeSent \n    reason: context canceled with 1h0m0s of the timeout remaining\n  expected: 1\n    actual: <nil>\n    buffer: 0/0")
+	})
+
+	t.Run("scenarios that must not fail tests", func(t *testing.T) {
+		assertThat := func(assumption string, check func(*Should)) {
+			stub := testingStub{}
+			should := New(&stub)
+
+			check(should)
+
+			if stub.hasFailed {
+				t.Error("test was expected to not fail but it did")
+			}
+			if stub.WasHelperCalled() {
+				t.Error("Helper() call was not expected but it happened")
+			}
+		}
+
+		assertThat("should pass for canceled contexts",
+			func(s *Should) { s.BeCanceled(canceled) })
+		assertThat("should pass for contexts past their deadline",
+			func(s *Should) { s.BeCanceled(expired) })
+		assertThat("should pass for deadlines within the duration",
+			func(s *Should) { s.HaveDeadlineWithin(expiring, 2*time.Minute) })
+		assertThat("should pass for expired deadlines",
+			func(s *Should) { s.HaveDeadlineWithin(expired, time.Minute) })
+		assertThat("should pass for context.Canceled",
+			func(s *Should) { s.ErrorIsContextCanceled(canceled.Err()) })
+		assertThat("should pass for wrapped context.Canceled",
+			func(s *Should) { s.ErrorIsContextCanceled(fmt.Errorf("fetching: %w", context.Canceled)) })
+		assertThat("should stop watching once the context is canceled",
+			func(s *Should) { s.WithContext(canceled).NotReceive(make(chan int), time.Hour) })
+		assertThat("should receive before the context is done",
+			func(s *Should) {
+				ch := make(chan int, 1)
+				ch <- 1
+				s.WithContext(expiring).ReceiveValue(ch, 1, time.Hour)
+			})
+	})
+
+	t.Run("derives a new instance", func(t *testing.T) {
+		s := New(&testingStub{})
+		derived := s.WithContext(canceled)
+
+		if s.context().Err() != nil || !errors.Is(derived.context().Err(), context.Canceled) {
+			t.Error("wanted only the derived instance to use the context")
+		}
+	})
+}
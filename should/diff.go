@@ -0,0 +1,157 @@
+package should
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// diffContextLines is how many unchanged lines are kept around each change.
+const diffContextLines int = 2
+
+// maxDiffCells bounds the size of the table diffLines builds for the lines that differ,
+// about 4MB, so large files are summarised instead of diffed.
+const maxDiffCells int = 1 << 20
+
+// diffLines compares expected and actual line by line and returns the
+// changes, with unchanged lines prefixed by two spaces, removed lines by "- "
+// and added lines by "+ ". Long runs of unchanged lines are elided.
+// Binary content, and changes too large to diff, are summarised in a single line.
+func diffLines(expected, actual string) []string {
+	if isBinary(expected) || isBinary(actual) {
+		return []string{contentDiffers(expected, actual)}
+	}
+
+	e, expectedNewline := splitLines(expected)
+	a, actualNewline := splitLines(actual)
+
+	// Only the lines between the common prefix and suffix need the table.
+	prefix := 0
+	for prefix < len(e) && prefix < len(a) && e[prefix] == a[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(e)-prefix && suffix < len(a)-prefix && e[len(e)-1-suffix] == a[len(a)-1-suffix] {
+		suffix++
+	}
+
+	me, ma := e[prefix:len(e)-suffix], a[prefix:len(a)-suffix]
+	if len(me)*len(ma) > maxDiffCells {
+		return []string{contentDiffers(expected, actual)}
+	}
+
+	var lines []string
+	for _, line := range e[:prefix] {
+		lines = append(lines, "  "+escapeLine(line))
+	}
+	lines = append(lines, diffMiddle(me, ma)...)
+	for _, line := range e[len(e)-suffix:] {
+		lines = append(lines, "  "+escapeLine(line))
+	}
+
+	switch {
+	case expectedNewline && !actualNewline:
+		lines = append(lines, "\\ no newline at end of actual")
+	case actualNewline && !expectedNewline:
+		lines = append(lines, "\\ no newline at end of expected")
+	}
+	return elideUnchanged(lines)
+}
+
+// splitLines splits text into its lines, reporting whether the last one ends with a new line
+// rather than returning an empty line after it.
+func splitLines(text string) ([]string, bool) {
+	if text == "" {
+		return nil, false
+	}
+	if strings.HasSuffix(text, "\n") {
+		return strings.Split(text[:len(text)-1], "\n"), true
+	}
+	return strings.Split(text, "\n"), false
+}
+
+// diffMiddle diffs e and a using the table of their longest common subsequences.
+func diffMiddle(e, a []string) []string {
+	// lcs[i][j] holds the length of the longest common subsequence of e[i:] and a[j:].
+	lcs := make([][]int32, len(e)+1)
+	for i := range lcs {
+		lcs[i] = make([]int32, len(a)+1)
+	}
+	for i := len(e) - 1; i >= 0; i-- {
+		for j := len(a) - 1; j >= 0; j-- {
+			switch {
+			case e[i] == a[j]:
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			case lcs[i+1][j] >= lcs[i][j+1]:
+				lcs[i][j] = lcs[i+1][j]
+			default:
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	var lines []string
+	i, j := 0, 0
+	for i < len(e) || j < len(a) {
+		switch {
+		case i < len(e) && j < len(a) && e[i] == a[j]:
+			lines = append(lines, "  "+escapeLine(e[i]))
+			i, j = i+1, j+1
+		case j < len(a) && (i == len(e) || lcs[i][j+1] > lcs[i+1][j]):
+			lines = append(lines, "+ "+escapeLine(a[j]))
+			j++
+		default:
+			lines = append(lines, "- "+escapeLine(e[i]))
+			i++
+		}
+	}
+
+	return lines
+}
+
+// isBinary reports whether content is not UTF-8 text, or contains NUL bytes.
+func isBinary(content string) bool {
+	return !utf8.ValidString(content) || strings.ContainsRune(content, 0)
+}
+
+func contentDiffers(expected, actual string) string {
+	return fmt.Sprintf("content differs (%d vs %d bytes)", len(expected), len(actual))
+}
+
+// elideUnchanged replaces unchanged lines that are further than
+// diffContextLines from any change with a single marker.
+func elideUnchanged(lines []string) []string {
+	keep := make([]bool, len(lines))
+	for i, line := range lines {
+		if strings.HasPrefix(line, "  ") {
+			continue
+		}
+		for k := i - diffContextLines; k <= i+diffContextLines; k++ {
+			if k >= 0 && k < len(lines) {
+				keep[k] = true
+			}
+		}
+	}
+
+	var result []string
+	for i := 0; i < len(lines); i++ {
+		if keep[i] {
+			result = append(result, lines[i])
+			continue
+		}
+
+		skipped := 0
+		for ; i < len(lines) && !keep[i]; i++ {
+			skipped++
+		}
+		i--
+		result = append(result, "  ... "+strconv.Itoa(skipped)+" unchanged")
+	}
+
+	return result
+}
+
+func escapeLine(line string) string {
+	return strings.ReplaceAll(line, "\t", "\\t")
+}
@@ -0,0 +1,50 @@
+package should
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestDiffLines(t *testing.T) {
+	assertThat := func(assumption string, expected, actual string, diff []string) {
+		result := diffLines(expected, actual)
+
+		if !reflect.DeepEqual(diff, result) {
+			t.Errorf("%s: wanted '%#v' got '%#v'", assumption, diff, result)
+		}
+	}
+
+	assertThat("should mark removed and added lines",
+		"a\nb\nc", "a\nc\nd",
+		[]string{"  a", "- b", "  c", "+ d"})
+	assertThat("should escape tabs",
+		"\tx", "\ty",
+		[]string{"- \\tx", "+ \\ty"})
+	assertThat("should elide unchanged lines far from changes",
+		"1\n2\n3\n4\n5\n6\n7\n8", "1\n2\n3\n4\n5\n6\n7\nx",
+		[]string{"  ... 5 unchanged", "  6", "  7", "- 8", "+ x"})
+	assertThat("should not report the end of trailing new lines as a line",
+		"a\nb\n", "a\nc\n",
+		[]string{"  a", "- b", "+ c"})
+	assertThat("should report a missing trailing new line",
+		"a\nb\n", "a\nb",
+		[]string{"  a", "  b", "\\ no newline at end of actual"})
+	assertThat("should summarise binary content",
+		"a\x00b", "a\xffb",
+		[]string{"content differs (3 vs 3 bytes)"})
+
+	lines := make([]string, 10000)
+	for i := range lines {
+		lines[i] = strconv.Itoa(i)
+	}
+	large := strings.Join(lines, "\n")
+
+	assertThat("should diff large content with few changes",
+		large, strings.Replace(large, "\n5000\n", "\nchanged\n", 1),
+		[]string{"  ... 4998 unchanged", "  4998", "  4999", "- 5000", "+ changed", "  5001", "  5002", "  ... 4997 unchanged"})
+	assertThat("should summarise large content that differs throughout",
+		large, strings.ReplaceAll(large, "1", "x"),
+		[]string{"content differs (48889 vs 48889 bytes)"})
+}
@@ -0,0 +1,37 @@
+package should
+
+import (
+	"fmt"
+	"strings"
+)
+
+const expectationsLogFormat string = "\nassumption: [ %s ]\n    should: %s \n    reason: %s\n   missing: %s\nunexpected: %s"
+
+// Expectations is implemented by test doubles, such as mock.Mock, that record calls against expectations.
+type Expectations interface {
+	MissingCalls() []string
+	UnexpectedCalls() []string
+}
+
+// MeetExpectations fails the test if mock is missing expected calls or received unexpected ones.
+func (s *Should) MeetExpectations(mock Expectations, assumption ...string) {
+	defer s.track("MeetExpectations", assumption)()
+
+	missing := mock.MissingCalls()
+	unexpected := mock.UnexpectedCalls()
+
+	if len(missing) > 0 || len(unexpected) > 0 {
+		s.t.Helper()
+		s.t.Log(fmt.Sprintf(expectationsLogFormat, describe(s.assumed(assumption), "MeetExpectations", "%[1]s meets its expectations"), "MeetExpectations",
+			"expectations not met", listOrNone(missing), listOrNone(unexpected)))
+		s.t.Fail()
+	}
+}
+
+func listOrNone(items []string) string {
+	if len(items) == 0 {
+		return "none"
+	}
+
+	return strings.Join(items, differencesIndent)
+}
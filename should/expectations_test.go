@@ -0,0 +1,91 @@
+package should
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/pjbgf/go-test/should/mock"
+)
+
+type storeMock struct {
+	mock.Mock
+}
+
+func (m *storeMock) Get(key string) (string, error) {
+	r := m.Called("Get", key)
+	return r.String(0), r.Error(1)
+}
+
+func TestMeetExpectations(t *testing.T) {
+	t.Run("scenarios that must fail tests", func(t *testing.T) {
+		assertThat := func(assumption string, setup func(*storeMock), expectedLogMessage string) {
+			stub := testingStub{}
+			should := New(&stub)
+			store := &storeMock{}
+
+			setup(store)
+			should.MeetExpectations(store, assumption)
+
+			if !stub.hasFailed {
+				t.Error("test was expected to fail but did not")
+			}
+			if !stub.WasHelperCalled() {
+				t.Error("Helper() call was expected but did not happen")
+			}
+			if expectedLogMessage != stub.logMessage {
+				t.Errorf("wanted '%s' got '%s'", expectedLogMessage, stub.logMessage)
+			}
+		}
+
+		assertThat("should fail for missing calls",
+			func(m *storeMock) {
+				m.On("Get", "a").Return("1", nil)
+				m.On("Get", mock.Any()).Times(2)
+				m.Get("a")
+				m.Get("b")
+			},
+			"\nassumption: [ should fail for missing calls ]\n    should: MeetExpectations \n    reason: expectations not met"+
+				"\n   missing: Get(<any>): expected 2 times, called 1 time\nunexpected: none")
+		assertThat("should fail for unexpected calls",
+			func(m *storeMock) {
+				m.On("Get", "a").Once()
+				m.Get("a")
+				m.Get("a")
+				m.Get("c")
+			},
+			"\nassumption: [ should fail for unexpected calls ]\n    should: MeetExpectations \n    reason: expectations not met"+
+				"\n   missing: none"+
+				"\nunexpected: Get(\"a\"): exceeds Get(\"a\"), expected 1 time"+
+				"\n            Get(\"c\"): no matching expectation")
+	})
+
+	t.Run("scenarios that must not fail tests", func(t *testing.T) {
+		assertThat := func(assumption string, setup func(*storeMock)) {
+			stub := testingStub{}
+			should := New(&stub)
+			store := &storeMock{}
+
+			setup(store)
+			should.MeetExpectations(store, assumption)
+
+			if stub.hasFailed {
+				t.Error("test was expected to not fail but it did")
+			}
+			if stub.WasHelperCalled() {
+				t.Error("Helper() call was not expected")
+			}
+			if stub.logMessage != "" {
+				t.Errorf("wanted '%s' got '%s'", "", stub.logMessage)
+			}
+		}
+
+		assertThat("should not fail when expectations are met", func(m *storeMock) {
+			m.On("Get", "a").Return("", errors.New("not found")).Once()
+			m.On("Get", mock.AnyOfType("")).Return("ok", nil)
+			m.On("Delete").Maybe()
+			m.Get("a")
+			m.Get("b")
+			m.Get("c")
+		})
+	})
+}
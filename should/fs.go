@@ -0,0 +1,183 @@
+package should
+
+import (
+	"bytes"
+	"fmt"
+	"io/fs"
+	"sort"
+	"strings"
+)
+
+const (
+	pathReasonLogFormat  string = "\nassumption: [ %s ]\n    should: %s \n    reason: %s\n      path: %s\n  expected: %v\n    actual: %v"
+	pathValuesLogFormat  string = "\nassumption: [ %s ]\n    should: %s \n      path: %s\n  expected: %v\n    actual: %v"
+	pathContentLogFormat string = "\nassumption: [ %s ]\n    should: %s \n      path: %s\n  expected: %v\n    actual: %v\n   differs: %s"
+	treeLogFormat        string = "\nassumption: [ %s ]\n    should: %s \n    reason: %s\n     added: %v\n   removed: %v\n  modified: %s"
+)
+
+// FileExist fails the test if name is not a regular file in fsys.
+// Use os.DirFS to check files on disk.
+func (s *Should) FileExist(fsys fs.FS, name string, assumption ...string) {
+	defer s.track("FileExist", assumption)()
+
+	info, err := fs.Stat(fsys, name)
+	if err != nil {
+		s.t.Helper()
+		s.t.Log(fmt.Sprintf(pathReasonLogFormat, describe(s.assumed(assumption), "FileExist", "%[2]s exists"), "FileExist",
+			err.Error(), name, "file", nil))
+		s.t.Fail()
+		return
+	}
+
+	if !info.Mode().IsRegular() {
+		s.t.Helper()
+		s.t.Log(fmt.Sprintf(pathReasonLogFormat, describe(s.assumed(assumption), "FileExist", "%[2]s exists"), "FileExist",
+			"not a regular file", name, "file", info.Mode()))
+		s.t.Fail()
+	}
+}
+
+// DirExist fails the test if name is not a directory in fsys.
+// Use os.DirFS to check directories on disk.
+func (s *Should) DirExist(fsys fs.FS, name string, assumption ...string) {
+	defer s.track("DirExist", assumption)()
+
+	info, err := fs.Stat(fsys, name)
+	if err != nil {
+		s.t.Helper()
+		s.t.Log(fmt.Sprintf(pathReasonLogFormat, describe(s.assumed(assumption), "DirExist", "%[2]s exists"), "DirExist",
+			err.Error(), name, "directory", nil))
+		s.t.Fail()
+		return
+	}
+
+	if !info.IsDir() {
+		s.t.Helper()
+		s.t.Log(fmt.Sprintf(pathReasonLogFormat, describe(s.assumed(assumption), "DirExist", "%[2]s exists"), "DirExist",
+			"not a directory", name, "directory", info.Mode()))
+		s.t.Fail()
+	}
+}
+
+// FileHaveContent fails the test if the content of the file name in fsys is not expected.
+func (s *Should) FileHaveContent(fsys fs.FS, name string, expected string, assumption ...string) {
+	defer s.track("FileHaveContent", assumption)()
+
+	content, err := fs.ReadFile(fsys, name)
+	if err != nil {
+		s.t.Helper()
+		s.t.Log(fmt.Sprintf(pathReasonLogFormat, describe(s.assumed(assumption), "FileHaveContent", "%[2]s has content %[3]s"), "FileHaveContent",
+			err.Error(), name, escape(expected), nil))
+		s.t.Fail()
+		return
+	}
+
+	if string(content) != expected {
+		s.t.Helper()
+		s.t.Log(fmt.Sprintf(pathContentLogFormat, describe(s.assumed(assumption), "FileHaveContent", "%[2]s has content %[3]s"), "FileHaveContent",
+			name, escape(expected), escape(string(content)), strings.Join(diffLines(expected, string(content)), differencesIndent)))
+		s.t.Fail()
+	}
+}
+
+// FileHaveMode fails the test if the file name in fsys does not have mode.
+// Only the type and permission bits are compared, so directories must be checked with fs.ModeDir set.
+func (s *Should) FileHaveMode(fsys fs.FS, name string, mode fs.FileMode, assumption ...string) {
+	defer s.track("FileHaveMode", assumption)()
+
+	info, err := fs.Stat(fsys, name)
+	if err != nil {
+		s.t.Helper()
+		s.t.Log(fmt.Sprintf(pathReasonLogFormat, describe(s.assumed(assumption), "FileHaveMode", "%[2]s has mode %[3]s"), "FileHaveMode",
+			err.Error(), name, mode, nil))
+		s.t.Fail()
+		return
+	}
+
+	if actual := info.Mode() & (fs.ModeType | fs.ModePerm); actual != mode {
+		s.t.Helper()
+		s.t.Log(fmt.Sprintf(pathValuesLogFormat, describe(s.assumed(assumption), "FileHaveMode", "%[2]s has mode %[3]s"), "FileHaveMode",
+			name, mode, actual))
+		s.t.Fail()
+	}
+}
+
+// DirTreeMatch walks both file systems and fails the test if they do not hold the same directories and files
+// with the same content. Failures list the added, removed and modified paths, with a diff for each modified file.
+func (s *Should) DirTreeMatch(expected, actual fs.FS, assumption ...string) {
+	defer s.track("DirTreeMatch", assumption)()
+
+	expectedTree, expectedErr := readTree(expected)
+	actualTree, actualErr := readTree(actual)
+
+	if expectedErr != nil || actualErr != nil {
+		s.t.Helper()
+		s.t.Log(fmt.Sprintf(reasonLogFormat, describe(s.assumed(assumption), "DirTreeMatch", "%[2]s matches %[1]s"), "DirTreeMatch",
+			"failed to walk tree", expectedErr, actualErr))
+		s.t.Fail()
+		return
+	}
+
+	var added, removed, modified []string
+	for _, path := range sortedPaths(expectedTree, actualTree) {
+		expectedContent, inExpected := expectedTree[path]
+		actualContent, inActual := actualTree[path]
+
+		switch {
+		case !inActual:
+			removed = append(removed, path)
+		case !inExpected:
+			added = append(added, path)
+		case !bytes.Equal(expectedContent, actualContent):
+			modified = append(modified, path)
+			for _, line := range diffLines(string(expectedContent), string(actualContent)) {
+				modified = append(modified, "  "+line)
+			}
+		}
+	}
+
+	if len(added) > 0 || len(removed) > 0 || len(modified) > 0 {
+		s.t.Helper()
+		s.t.Log(fmt.Sprintf(treeLogFormat, describe(s.assumed(assumption), "DirTreeMatch", "%[2]s matches %[1]s"), "DirTreeMatch",
+			"trees differ", added, removed, strings.Join(modified, differencesIndent)))
+		s.t.Fail()
+	}
+}
+
+// readTree returns the content of every file in fsys keyed by path.
+// Directories are included with a trailing slash and no content.
+func readTree(fsys fs.FS) (map[string][]byte, error) {
+	tree := make(map[string][]byte)
+	err := fs.WalkDir(fsys, ".", func(path string, entry fs.DirEntry, err error) error {
+		if err != nil || path == "." {
+			return err
+		}
+
+		if entry.IsDir() {
+			tree[path+"/"] = nil
+			return nil
+		}
+
+		content, err := fs.ReadFile(fsys, path)
+		tree[path] = content
+		return err
+	})
+
+	return tree, err
+}
+
+func sortedPaths(trees ...map[string][]byte) []string {
+	seen := make(map[string]bool)
+	var paths []string
+	for _, tree := range trees {
+		for path := range tree {
+			if !seen[path] {
+				seen[path] = true
+				paths = append(paths, path)
+			}
+		}
+	}
+
+	sort.Strings(paths)
+	return paths
+}
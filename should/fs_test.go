@@ -0,0 +1,95 @@
+package should
+
+import (
+	"io/fs"
+	"testing"
+	"testing/fstest"
+)
+
+func TestFileSystem(t *testing.T) {
+	fsys := fstest.MapFS{
+		"bin/app":        {Data: []byte("#!/bin/sh\n"), Mode: 0755},
+		"etc/app.conf":   {Data: []byte("port=80\nhost=a\n"), Mode: 0644},
+		"var/log/app.db": {Data: []byte{}},
+	}
+
+	t.Run("scenarios that must fail tests", func(t *testing.T) {
+		assertThat := func(assumption string, check func(*Should), expectedLogMessage string) {
+			stub := testingStub{}
+			should := New(&stub)
+
+			check(should)
+
+			if !stub.hasFailed {
+				t.Error("test was expected to fail but did not")
+			}
+			if !stub.WasHelperCalled() {
+				t.Error("Helper() call was expected but did not happen")
+			}
+			if expectedLogMessage != stub.logMessage {
+				t.Errorf("wanted '%s' got '%s'", expectedLogMessage, stub.logMessage)
+			}
+		}
+
+		assertThat("should fail for missing file",
+			func(s *Should) { s.FileExist(fsys, "etc/missing", "config is written") },
+			"\nassumption: [ config is written ]\n    should: FileExist \n    reason: open etc/missing: file does not exist\n      path: etc/missing\n  expected: file\n    actual: <nil>")
+		assertThat("should fail for directory instead of file",
+			func(s *Should) { s.FileExist(fsys, "etc", "config is written") },
+			"\nassumption: [ config is written ]\n    should: FileExist \n    reason: not a regular file\n      path: etc\n  expected: file\n    actual: dr-xr-xr-x")
+		assertThat("should fail for file instead of directory",
+			func(s *Should) { s.DirExist(fsys, "bin/app", "bin is created") },
+			"\nassumption: [ bin is created ]\n    should: DirExist \n    reason: not a directory\n      path: bin/app\n  expected: directory\n    actual: -rwxr-xr-x")
+		assertThat("should fail for different content",
+			func(s *Should) { s.FileHaveContent(fsys, "etc/app.conf", "port=80\nhost=b\n", "config is rendered") },
+			"\nassumption: [ config is rendered ]\n    should: FileHaveContent \n      path: etc/app.conf\n  expected: port=80\\nhost=b\\n\n    actual: port=80\\nhost=a\\n"+
+				"\n   differs:   port=80\n            - host=b\n            + host=a")
+		assertThat("should fail for different mode",
+			func(s *Should) { s.FileHaveMode(fsys, "bin/app", 0700, "app is private") },
+			"\nassumption: [ app is private ]\n    should: FileHaveMode \n      path: bin/app\n  expected: -rwx------\n    actual: -rwxr-xr-x")
+		assertThat("should fail for different trees",
+			func(s *Should) {
+				s.DirTreeMatch(fstest.MapFS{
+					"bin/app":      {Data: []byte("#!/bin/sh\n")},
+					"etc/app.conf": {Data: []byte("port=80\nhost=b\n")},
+					"etc/old.conf": {Data: []byte("")},
+				}, fsys, "output matches golden tree")
+			},
+			"\nassumption: [ output matches golden tree ]\n    should: DirTreeMatch \n    reason: trees differ"+
+				"\n     added: [var/ var/log/ var/log/app.db]\n   removed: [etc/old.conf]"+
+				"\n  modified: etc/app.conf\n                port=80\n              - host=b\n              + host=a")
+	})
+
+	t.Run("scenarios that must not fail tests", func(t *testing.T) {
+		assertThat := func(assumption string, check func(*Should)) {
+			stub := testingStub{}
+			should := New(&stub)
+
+			check(should)
+
+			if stub.hasFailed {
+				t.Error("test was expected to not fail but it did")
+			}
+			if stub.WasHelperCalled() {
+				t.Error("Helper() call was not expected")
+			}
+			if stub.logMessage != "" {
+				t.Errorf("wanted '%s' got '%s'", "", stub.logMessage)
+			}
+		}
+
+		assertThat("should not fail for existing file", func(s *Should) { s.FileExist(fsys, "var/log/app.db") })
+		assertThat("should not fail for existing directory", func(s *Should) { s.DirExist(fsys, "var/log") })
+		assertThat("should not fail for same content", func(s *Should) { s.FileHaveContent(fsys, "bin/app", "#!/bin/sh\n") })
+		assertThat("should not fail for same mode", func(s *Should) { s.FileHaveMode(fsys, "etc/app.conf", 0644) })
+		assertThat("should not fail for directory mode", func(s *Should) { s.FileHaveMode(fsys, "etc", fs.ModeDir|0555) })
+		assertThat("should not fail for same trees", func(s *Should) {
+			s.DirTreeMatch(fstest.MapFS{
+				"var/log/app.db": {},
+				"etc/app.conf":   {Data: []byte("port=80\nhost=a\n")},
+				"bin/app":        {Data: []byte("#!/bin/sh\n")},
+			}, fsys)
+		})
+	})
+
+}
@@ -0,0 +1,68 @@
+package should
+
+import (
+	"fmt"
+	"reflect"
+)
+
+const fuzzInputLogFormat string = "\nfuzz input: %s"
+
+// corpusT is implemented by *testing.F.
+type corpusT interface {
+	TestingT
+	Add(args ...interface{})
+}
+
+// NewFuzz initialises a new Should instance for a fuzz callback, whose failures include the fuzz inputs.
+//
+//	f.Fuzz(func(t *testing.T, value1, value2 int) {
+//		should := should.NewFuzz(t, value1, value2)
+//		should.BeEqual(value2+value1, Sum(value1, value2))
+//	})
+func NewFuzz(t TestingT, inputs ...interface{}) *Should {
+	return newShould(t, func(t TestingT) TestingT { return &fuzzT{TestingT: t, inputs: inputs} }, nil)
+}
+
+// SeedCorpus returns an assertThat-style function that adds the first inputs values of each example
+// to the seed corpus of f, so example tables can be shared between unit tests and fuzz targets:
+//
+//	assertThat := should.SeedCorpus(f, 2)
+//	assertThat("should return 13 for 4 and 9", 4, 9, 13)
+//
+// The assumption and the values after the inputs, such as expected results, are dropped.
+func SeedCorpus(f corpusT, inputs int) func(assumption string, values ...interface{}) {
+	return func(assumption string, values ...interface{}) {
+		if len(values) < inputs {
+			f.Helper()
+			f.Log(fmt.Sprintf(reasonLogFormat, assumption, "SeedCorpus",
+				fmt.Sprintf("example has %d values", len(values)), fmt.Sprintf("%d inputs", inputs), formatInputs(reflectValues(values))))
+			f.Fail()
+			return
+		}
+
+		f.Add(values[:inputs]...)
+	}
+}
+
+func reflectValues(values []interface{}) []reflect.Value {
+	rvs := make([]reflect.Value, len(values))
+	for i, value := range values {
+		rvs[i] = reflect.ValueOf(value)
+	}
+	return rvs
+}
+
+// fuzzT appends the fuzz inputs to every message logged.
+type fuzzT struct {
+	TestingT
+	inputs []interface{}
+}
+
+func (t *fuzzT) Log(args ...interface{}) {
+	t.TestingT.Helper()
+	t.TestingT.Log(fmt.Sprint(args...) + fmt.Sprintf(fuzzInputLogFormat, formatInputs(reflectValues(t.inputs))))
+}
+
+func (t *fuzzT) unwrap() TestingT {
+	return t.TestingT
+}
@@ -0,0 +1,101 @@
+package should
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type corpusStub struct {
+	testingStub
+	corpus [][]interface{}
+}
+
+func (f *corpusStub) Add(args ...interface{}) {
+	f.corpus = append(f.corpus, args)
+}
+
+func TestNewFuzz(t *testing.T) {
+	t.Run("scenarios that must fail tests", func(t *testing.T) {
+		assertThat := func(assumption string, inputs []interface{}, expectedLogMessage string) {
+			stub := testingStub{}
+			should := NewFuzz(&stub, inputs...)
+
+			should.BeTrue(false, assumption)
+
+			if !stub.hasFailed {
+				t.Error("test was expected to fail but did not")
+			}
+			if !stub.WasHelperCalled() {
+				t.Error("Helper() call was expected but did not happen")
+			}
+			if expectedLogMessage != stub.logMessage {
+				t.Errorf("wanted '%s' got '%s'", expectedLogMessage, stub.logMessage)
+			}
+		}
+
+		assertThat("should include the fuzz inputs", []interface{}{"a\tb", 3},
+			"\nassumption: [ should include the fuzz inputs ]\n    should: BeTrue \n  expected: true\n    actual: false"+
+				"\nfuzz input: \"a\\tb\", 3")
+		assertThat("should print bytes as strings", []interface{}{[]byte("\x00ab"), uint8(7)},
+			"\nassumption: [ should print bytes as strings ]\n    should: BeTrue \n  expected: true\n    actual: false"+
+				"\nfuzz input: []byte(\"\\x00ab\"), 7")
+	})
+
+	t.Run("scenarios that must not fail tests", func(t *testing.T) {
+		stub := testingStub{}
+		should := NewFuzz(&stub, "input")
+
+		should.BeEqual(1, 1, "should not fail when assertions pass")
+
+		if stub.hasFailed || stub.WasHelperCalled() || stub.logMessage != "" {
+			t.Errorf("test was expected to not fail but it did: '%s'", stub.logMessage)
+		}
+	})
+}
+
+func TestSeedCorpus(t *testing.T) {
+	t.Run("adds the inputs of each example", func(t *testing.T) {
+		f := corpusStub{}
+		assertThat := SeedCorpus(&f, 2)
+
+		assertThat("should return 13 for 4 and 9", 4, 9, 13)
+		assertThat("should return 50 for 15 and 35", 15, 35, 50)
+
+		expected := [][]interface{}{{4, 9}, {15, 35}}
+		if !reflect.DeepEqual(expected, f.corpus) {
+			t.Errorf("wanted '%v' got '%v'", expected, f.corpus)
+		}
+		if f.hasFailed {
+			t.Error("test was expected to not fail but it did")
+		}
+	})
+
+	t.Run("fails for examples with too few values", func(t *testing.T) {
+		f := corpusStub{}
+		assertThat := SeedCorpus(&f, 2)
+
+		assertThat("should return 4 for 4", 4)
+
+		expectedLogMessage := "\nassumption: [ should return 4 for 4 ]\n    should: SeedCorpus \n    reason: example has 1 values" +
+			"\n  expected: 2 inputs\n    actual: 4"
+		if !f.hasFailed || !f.WasHelperCalled() || len(f.corpus) != 0 {
+			t.Error("test was expected to fail but did not")
+		}
+		if expectedLogMessage != f.logMessage {
+			t.Errorf("wanted '%s' got '%s'", expectedLogMessage, f.logMessage)
+		}
+	})
+}
+
+func FuzzSeedCorpus(f *testing.F) {
+	assertThat := SeedCorpus(f, 2)
+	assertThat("should split on commas", "a,b", ",", []string{"a", "b"})
+	assertThat("should split on spaces", "a b c", " ", []string{"a", "b", "c"})
+
+	f.Fuzz(func(t *testing.T, value, sep string) {
+		should := NewFuzz(t, value, sep)
+
+		should.BeEqual(value, strings.Join(strings.Split(value, sep), sep), "split and join round-trip")
+	})
+}
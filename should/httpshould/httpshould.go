@@ -0,0 +1,225 @@
+// Package httpshould provide methods for testing http handlers.
+package httpshould
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/http/httputil"
+	"strings"
+
+	"github.com/pjbgf/go-test/should"
+)
+
+const (
+	dumpLogFormat string = "\n   request: %s\n  response: %s"
+
+	dumpIndent string = "\n            "
+)
+
+// Tester builds requests that are served by an http.Handler.
+type Tester struct {
+	t       should.TestingT
+	handler http.Handler
+}
+
+// Request is a request waiting to be served.
+type Request struct {
+	t       should.TestingT
+	handler http.Handler
+	request *http.Request
+	body    string
+}
+
+// Response holds what the handler wrote and provides assertions on it.
+// Failures include the full request and response dump.
+type Response struct {
+	t        *dumpingT
+	should   *should.Should
+	request  *http.Request
+	recorder *httptest.ResponseRecorder
+}
+
+// New initialises a new Tester instance for handler.
+func New(t should.TestingT, handler http.Handler) *Tester {
+	return &Tester{t, handler}
+}
+
+// Request starts building a request with the given method and target.
+func (h *Tester) Request(method, target string) *Request {
+	return &Request{t: h.t, handler: h.handler, request: httptest.NewRequest(method, target, nil)}
+}
+
+// Get starts building a GET request to target.
+func (h *Tester) Get(target string) *Request {
+	return h.Request(http.MethodGet, target)
+}
+
+// Post starts building a POST request to target.
+func (h *Tester) Post(target string) *Request {
+	return h.Request(http.MethodPost, target)
+}
+
+// Put starts building a PUT request to target.
+func (h *Tester) Put(target string) *Request {
+	return h.Request(http.MethodPut, target)
+}
+
+// Patch starts building a PATCH request to target.
+func (h *Tester) Patch(target string) *Request {
+	return h.Request(http.MethodPatch, target)
+}
+
+// Delete starts building a DELETE request to target.
+func (h *Tester) Delete(target string) *Request {
+	return h.Request(http.MethodDelete, target)
+}
+
+// WithHeader adds a header to the request.
+func (r *Request) WithHeader(key, value string) *Request {
+	r.request.Header.Add(key, value)
+	return r
+}
+
+// WithBody sets the request body.
+func (r *Request) WithBody(body string) *Request {
+	r.body = body
+	return r
+}
+
+// WithJSON sets the request body and its content type to JSON.
+func (r *Request) WithJSON(body string) *Request {
+	r.request.Header.Set("Content-Type", "application/json")
+	return r.WithBody(body)
+}
+
+// Do serves the request with the handler and records the response.
+func (r *Request) Do() *Response {
+	request := httptest.NewRequest(r.request.Method, r.request.URL.String(), strings.NewReader(r.body))
+	request.Header = r.request.Header
+
+	response := &Response{request: request, recorder: httptest.NewRecorder()}
+	response.t = &dumpingT{TestingT: r.t, request: dumpRequest(request)}
+
+	r.handler.ServeHTTP(response.recorder, request)
+	response.t.response = dumpResponse(response.recorder)
+	response.should = should.New(response.t)
+
+	return response
+}
+
+// Recorder returns the recorder the handler wrote to, for checks not covered by Response.
+func (r *Response) Recorder() *httptest.ResponseRecorder {
+	return r.recorder
+}
+
+// HaveStatus fails the test if the response status code is not code.
+func (r *Response) HaveStatus(code int, assumption ...string) {
+	r.t.Helper()
+	description := r.describe(assumption, "has status %d", code)
+	r.should.Assert("HaveStatus", func(description string) string {
+		if r.recorder.Code == code {
+			return ""
+		}
+		return should.Failure(description, "HaveStatus", "expected", statusText(code), "actual", statusText(r.recorder.Code))
+	}, description)
+}
+
+// HaveHeader fails the test if the response header key does not have value.
+func (r *Response) HaveHeader(key, value string, assumption ...string) {
+	r.t.Helper()
+	description := r.describe(assumption, "has header %s: %s", key, value)
+	r.should.Assert("HaveHeader", func(description string) string {
+		values, ok := r.recorder.Header()[http.CanonicalHeaderKey(key)]
+		if !ok {
+			return should.Failure(description, "HaveHeader", "reason", "header missing", "expected", value, "actual", nil)
+		}
+		if values[0] != value {
+			return should.Failure(description, "HaveHeader", "expected", value, "actual", values[0])
+		}
+		return ""
+	}, description)
+}
+
+// HaveBodyJSON fails the test if the response body is not semantically equal to the JSON document in expected.
+// Expected can be a string, []byte or io.Reader.
+func (r *Response) HaveBodyJSON(expected interface{}, assumption ...string) {
+	r.t.Helper()
+	r.should.BeEqualJSON(expected, r.recorder.Body.String(), r.describe(assumption, "has JSON body"))
+}
+
+// HaveBodyContaining fails the test if expected is not within the response body.
+func (r *Response) HaveBodyContaining(expected string, assumption ...string) {
+	r.t.Helper()
+	r.should.ContainSubstring(expected, r.recorder.Body.String(), r.describe(assumption, "has body containing %q", expected))
+}
+
+// Redirect fails the test if the response is not a redirect to the location to.
+func (r *Response) Redirect(to string, assumption ...string) {
+	r.t.Helper()
+	description := r.describe(assumption, "redirects to %s", to)
+	r.should.Assert("Redirect", func(description string) string {
+		if r.recorder.Code < 300 || r.recorder.Code > 399 {
+			return should.Failure(description, "Redirect", "reason", "not a redirect", "expected", to, "actual", statusText(r.recorder.Code))
+		}
+		if location := r.recorder.Header().Get("Location"); location != to {
+			return should.Failure(description, "Redirect", "expected", to, "actual", location)
+		}
+		return ""
+	}, description)
+}
+
+// describe returns the assumption supplied by the caller or, when none was
+// given, one made of the request line and the expectation.
+func (r *Response) describe(assumption []string, format string, args ...interface{}) string {
+	text := strings.TrimSpace(strings.Join(assumption, " "))
+	if text != "" {
+		return text
+	}
+
+	return r.request.Method + " " + r.request.URL.RequestURI() + " " + fmt.Sprintf(format, args...)
+}
+
+func statusText(code int) string {
+	return fmt.Sprintf("%d %s", code, http.StatusText(code))
+}
+
+// dumpingT appends the request and response dumps to every message logged.
+type dumpingT struct {
+	should.TestingT
+	request  string
+	response string
+}
+
+func (t *dumpingT) Log(args ...interface{}) {
+	t.TestingT.Helper()
+	t.TestingT.Log(fmt.Sprint(args...) + fmt.Sprintf(dumpLogFormat, t.request, t.response))
+}
+
+// Unwrap returns the runner t decorates, so should detects its capabilities, such as its name.
+func (t *dumpingT) Unwrap() should.TestingT {
+	return t.TestingT
+}
+
+func dumpRequest(request *http.Request) string {
+	dump, err := httputil.DumpRequest(request, true)
+	if err != nil {
+		return err.Error()
+	}
+
+	return indentDump(dump)
+}
+
+func dumpResponse(recorder *httptest.ResponseRecorder) string {
+	dump, err := httputil.DumpResponse(recorder.Result(), true)
+	if err != nil {
+		return err.Error()
+	}
+
+	return indentDump(dump)
+}
+
+func indentDump(dump []byte) string {
+	lines := strings.Split(strings.TrimRight(strings.ReplaceAll(string(dump), "\r\n", "\n"), "\n"), "\n")
+	return strings.Join(lines, dumpIndent)
+}
@@ -0,0 +1,172 @@
+package httpshould
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/pjbgf/go-test/should"
+)
+
+// New takes the runners of should, so callers can name its parameter when wrapping it.
+var _ func(should.TestingT, http.Handler) *Tester = New
+
+type testingStub struct {
+	hasFailed    bool
+	helperCalled bool
+	logMessage   string
+}
+
+func (t *testingStub) Helper() {
+	t.helperCalled = true
+}
+
+func (t *testingStub) Log(args ...interface{}) {
+	t.logMessage = fmt.Sprint(args...)
+}
+
+func (t *testingStub) Fail() {
+	t.hasFailed = true
+}
+
+func handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/users", func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, `{"id":1,"request":%s}`, body)
+	})
+	mux.HandleFunc("/old", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/new", http.StatusMovedPermanently)
+	})
+
+	return mux
+}
+
+func TestResponse(t *testing.T) {
+	t.Run("scenarios that must fail tests", func(t *testing.T) {
+		assertThat := func(assumption string, check func(*Response), expectedLogMessages ...string) {
+			stub := testingStub{}
+			response := New(&stub, handler()).Post("/users").WithJSON(`{"name":"a"}`).Do()
+
+			check(response)
+
+			if !stub.hasFailed {
+				t.Errorf("%s: test was expected to fail but did not", assumption)
+			}
+			if !stub.helperCalled {
+				t.Errorf("%s: Helper() call was expected but did not happen", assumption)
+			}
+			for _, expected := range expectedLogMessages {
+				if !strings.Contains(stub.logMessage, expected) {
+					t.Errorf("%s: wanted log containing '%s' got '%s'", assumption, expected, stub.logMessage)
+				}
+			}
+		}
+
+		assertThat("should fail for different status",
+			func(r *Response) { r.HaveStatus(http.StatusOK) },
+			"\nassumption: [ POST /users has status 200 ]\n    should: HaveStatus \n  expected: 200 OK\n    actual: 201 Created"+
+				"\n   request: POST /users HTTP/1.1"+
+				"\n            Host: example.com"+
+				"\n            Content-Type: application/json"+
+				"\n            \n            {\"name\":\"a\"}"+
+				"\n  response: HTTP/1.1 201 Created",
+			"\n            {\"id\":1,\"request\":{\"name\":\"a\"}}")
+		assertThat("should fail for missing header",
+			func(r *Response) { r.HaveHeader("X-Request-Id", "1", "request id is set") },
+			"\nassumption: [ request id is set ]\n    should: HaveHeader \n    reason: header missing\n  expected: 1\n    actual: <nil>")
+		assertThat("should fail for different header",
+			func(r *Response) { r.HaveHeader("content-type", "text/plain") },
+			"\nassumption: [ POST /users has header content-type: text/plain ]\n    should: HaveHeader \n  expected: text/plain\n    actual: application/json")
+		assertThat("should fail for different json body",
+			func(r *Response) { r.HaveBodyJSON(`{"id":2,"request":{"name":"a"}}`) },
+			"\nassumption: [ POST /users has JSON body ]\n    should: BeEqualJSON \n    reason: documents differ",
+			"\n   differs: /id: expected 2, actual 1\n   request: POST /users HTTP/1.1")
+		assertThat("should fail for missing body content",
+			func(r *Response) { r.HaveBodyContaining(`"id":2`) },
+			"\nassumption: [ POST /users has body containing \"\\\"id\\\":2\" ]\n    should: ContainSubstring ",
+			"\n   closest: \"id\":1 (offset 1)\n   request: ")
+		assertThat("should fail for responses that do not redirect",
+			func(r *Response) { r.Redirect("/new") },
+			"\nassumption: [ POST /users redirects to /new ]\n    should: Redirect \n    reason: not a redirect\n  expected: /new\n    actual: 201 Created")
+	})
+
+	t.Run("scenarios that must not fail tests", func(t *testing.T) {
+		assertThat := func(assumption string, request func(*Tester) *Request, check func(*Response)) {
+			stub := testingStub{}
+
+			check(request(New(&stub, handler())).Do())
+
+			if stub.hasFailed {
+				t.Errorf("%s: test was expected to not fail but it did", assumption)
+			}
+			if stub.logMessage != "" {
+				t.Errorf("%s: wanted '%s' got '%s'", assumption, "", stub.logMessage)
+			}
+		}
+
+		assertThat("should not fail for matching json response",
+			func(h *Tester) *Request { return h.Post("/users").WithJSON(`{"name":"a"}`) },
+			func(r *Response) {
+				r.HaveStatus(http.StatusCreated)
+				r.HaveHeader("Content-Type", "application/json")
+				r.HaveBodyJSON(`{"request":{"name":"a"},"id":1}`)
+				r.HaveBodyContaining(`"id":1`)
+			})
+		assertThat("should not fail for redirects",
+			func(h *Tester) *Request { return h.Get("/old") },
+			func(r *Response) {
+				r.HaveStatus(http.StatusMovedPermanently)
+				r.Redirect("/new")
+			})
+	})
+}
+
+type namedStub struct {
+	testingStub
+}
+
+func (t *namedStub) Name() string {
+	return "TestUsers"
+}
+
+type mainStub func() int
+
+func (m mainStub) Run() int {
+	return m()
+}
+
+func TestReporting(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "junit.xml")
+
+	should.RunWithJUnit(mainStub(func() int {
+		response := New(&namedStub{}, handler()).Get("/old").Do()
+		response.HaveStatus(http.StatusMovedPermanently)
+		response.HaveHeader("Location", "/other")
+		response.Redirect("/new")
+		response.HaveBodyContaining("Moved")
+		return 0
+	}), path)
+
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, expected := range []string{
+		`<testsuite package="" id="0" name="TestUsers"`, `tests="4" failures="1"`,
+		`<testcase name="GET /old has status 301" classname="TestUsers"`,
+		`<testcase name="GET /old has header Location: /other" classname="TestUsers"`,
+		`<failure message="GET /old has header Location: /other" type="HaveHeader">`,
+		`<testcase name="GET /old redirects to /new" classname="TestUsers"`,
+		`<testcase name="GET /old has body containing &#34;Moved&#34;" classname="TestUsers"`,
+	} {
+		if !strings.Contains(string(content), expected) {
+			t.Errorf("wanted report containing '%s' got '%s'", expected, content)
+		}
+	}
+}
@@ -0,0 +1,353 @@
+package should
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math/big"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	differencesLogFormat string = "\nassumption: [ %s ]\n    should: %s \n    reason: %s\n  expected: %v\n    actual: %v\n   differs: %v"
+
+	differencesIndent string = "\n            "
+
+	// AnyJSON is a ContainJSON placeholder that matches any value.
+	AnyJSON string = "<<ANY>>"
+	// UUIDJSON is a ContainJSON placeholder that matches any string holding a UUID.
+	UUIDJSON string = "<<UUID>>"
+	// RFC3339JSON is a ContainJSON placeholder that matches any string holding an RFC3339 timestamp.
+	RFC3339JSON string = "<<RFC3339>>"
+)
+
+var uuidPattern = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+// BeEqualJSON compares the JSON documents in expected and actual and fails the test if they are not semantically equal.
+// Key order and whitespace are ignored. Both values can be a string, []byte or io.Reader.
+func (s *Should) BeEqualJSON(expected, actual interface{}, assumption ...string) {
+	defer s.track("BeEqualJSON", assumption)()
+
+	expectedText, expectedDoc, expectedErr := decodeJSON(expected)
+	actualText, actualDoc, actualErr := decodeJSON(actual)
+
+	if expectedErr != nil || actualErr != nil {
+		s.t.Helper()
+		s.t.Log(fmt.Sprintf(reasonLogFormat, describe(s.assumed(assumption), "BeEqualJSON", "%[2]s equals JSON %[1]s"), "BeEqualJSON",
+			invalidDocumentReason("JSON", expectedErr, actualErr), escape(expectedText), escape(actualText)))
+		s.t.Fail()
+		return
+	}
+
+	differences := diffJSON("", expectedDoc, actualDoc)
+	if len(differences) > 0 {
+		s.t.Helper()
+		s.t.Log(fmt.Sprintf(differencesLogFormat, describe(s.assumed(assumption), "BeEqualJSON", "%[2]s equals JSON %[1]s"), "BeEqualJSON",
+			"documents differ", escape(expectedText), escape(actualText), strings.Join(differences, differencesIndent)))
+		s.t.Fail()
+	}
+}
+
+// ContainJSON fails the test if the JSON document in expected is not contained within actual.
+// Objects in actual may have additional members, and arrays may have additional elements in any order.
+// The string placeholders AnyJSON, UUIDJSON and RFC3339JSON match any value of that shape.
+// Both values can be a string, []byte or io.Reader.
+func (s *Should) ContainJSON(expected, actual interface{}, assumption ...string) {
+	defer s.track("ContainJSON", assumption)()
+
+	expectedText, expectedDoc, expectedErr := decodeJSON(expected)
+	actualText, actualDoc, actualErr := decodeJSON(actual)
+
+	if expectedErr != nil || actualErr != nil {
+		s.t.Helper()
+		s.t.Log(fmt.Sprintf(reasonLogFormat, describe(s.assumed(assumption), "ContainJSON", "%[2]s contains JSON %[1]s"), "ContainJSON",
+			invalidDocumentReason("JSON", expectedErr, actualErr), escape(expectedText), escape(actualText)))
+		s.t.Fail()
+		return
+	}
+
+	differences := subsetJSON("", expectedDoc, actualDoc)
+	if len(differences) > 0 {
+		s.t.Helper()
+		s.t.Log(fmt.Sprintf(differencesLogFormat, describe(s.assumed(assumption), "ContainJSON", "%[2]s contains JSON %[1]s"), "ContainJSON",
+			"fragment not found", escape(expectedText), escape(actualText), strings.Join(differences, differencesIndent)))
+		s.t.Fail()
+	}
+}
+
+// decodeJSON reads value and decodes it as a single JSON document, returning
+// the raw text alongside it so that failures can show what was read.
+func decodeJSON(value interface{}) (text string, doc interface{}, err error) {
+	data, err := readDocument(value)
+	if err != nil {
+		return string(data), nil, err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err = decoder.Decode(&doc); err != nil {
+		return string(data), nil, err
+	}
+
+	if _, err = decoder.Token(); err != io.EOF {
+		return string(data), nil, errors.New("unexpected data after top-level value")
+	}
+
+	return string(data), doc, nil
+}
+
+// readDocument returns the content of value, which can be a string, []byte or io.Reader.
+// For unsupported types, the value's default format is returned alongside the error.
+func readDocument(value interface{}) ([]byte, error) {
+	switch v := value.(type) {
+	case string:
+		return []byte(v), nil
+	case []byte:
+		return v, nil
+	case io.Reader:
+		return ioutil.ReadAll(v)
+	}
+
+	return []byte(fmt.Sprintf("%v", value)), fmt.Errorf("unsupported type %T", value)
+}
+
+func invalidDocumentReason(format string, expectedErr, actualErr error) string {
+	var reasons []string
+	if expectedErr != nil {
+		reasons = append(reasons, "invalid expected "+format+": "+expectedErr.Error())
+	}
+	if actualErr != nil {
+		reasons = append(reasons, "invalid actual "+format+": "+actualErr.Error())
+	}
+
+	return strings.Join(reasons, "; ")
+}
+
+// diffJSON walks both decoded documents and returns one entry per difference,
+// each identified by its JSON Pointer (RFC 6901).
+func diffJSON(pointer string, expected, actual interface{}) []string {
+	switch e := expected.(type) {
+	case map[string]interface{}:
+		a, ok := actual.(map[string]interface{})
+		if !ok {
+			break
+		}
+
+		var differences []string
+		for _, key := range sortedKeys(e, a) {
+			path := pointer + "/" + escapePointer(key)
+			expectedValue, inExpected := e[key]
+			actualValue, inActual := a[key]
+
+			switch {
+			case !inActual:
+				differences = append(differences, path+": missing")
+			case !inExpected:
+				differences = append(differences, path+": unexpected")
+			default:
+				differences = append(differences, diffJSON(path, expectedValue, actualValue)...)
+			}
+		}
+		return differences
+
+	case []interface{}:
+		a, ok := actual.([]interface{})
+		if !ok {
+			break
+		}
+
+		var differences []string
+		for i := 0; i < len(e) || i < len(a); i++ {
+			path := pointer + "/" + strconv.Itoa(i)
+
+			switch {
+			case i >= len(a):
+				differences = append(differences, path+": missing")
+			case i >= len(e):
+				differences = append(differences, path+": unexpected")
+			default:
+				differences = append(differences, diffJSON(path, e[i], a[i])...)
+			}
+		}
+		return differences
+
+	case json.Number:
+		if a, ok := actual.(json.Number); ok && equalNumbers(e, a) {
+			return nil
+		}
+
+	default:
+		if expected == actual {
+			return nil
+		}
+	}
+
+	return []string{fmt.Sprintf("%s: expected %s, actual %s", rootPointer(pointer), compactJSON(expected), compactJSON(actual))}
+}
+
+// subsetJSON works like diffJSON, but only requires expected to be present
+// within actual and honours placeholders.
+func subsetJSON(pointer string, expected, actual interface{}) []string {
+	switch e := expected.(type) {
+	case string:
+		if matched, isPlaceholder := matchPlaceholder(e, actual); isPlaceholder {
+			if matched {
+				return nil
+			}
+			return []string{fmt.Sprintf("%s: expected %s, actual %s", rootPointer(pointer), e, compactJSON(actual))}
+		}
+
+	case map[string]interface{}:
+		a, ok := actual.(map[string]interface{})
+		if !ok {
+			break
+		}
+
+		var differences []string
+		for _, key := range sortedKeys(e) {
+			path := pointer + "/" + escapePointer(key)
+			actualValue, inActual := a[key]
+			if !inActual {
+				differences = append(differences, path+": missing")
+				continue
+			}
+			differences = append(differences, subsetJSON(path, e[key], actualValue)...)
+		}
+		return differences
+
+	case []interface{}:
+		a, ok := actual.([]interface{})
+		if !ok {
+			break
+		}
+
+		var differences []string
+		for _, i := range unmatchedElements(e, a) {
+			differences = append(differences, fmt.Sprintf("%s/%d: no element matches %s", pointer, i, compactJSON(e[i])))
+		}
+		return differences
+	}
+
+	return diffJSON(pointer, expected, actual)
+}
+
+// unmatchedElements pairs every expected element with a distinct actual element containing it,
+// and returns the indexes of the expected elements left without one. It finds the largest pairing
+// using augmenting paths, so a loose element such as a placeholder never takes the only actual
+// element a stricter one could match.
+func unmatchedElements(expected, actual []interface{}) []int {
+	matches := make([][]bool, len(expected))
+	for i := range expected {
+		matches[i] = make([]bool, len(actual))
+		for j := range actual {
+			matches[i][j] = len(subsetJSON("", expected[i], actual[j])) == 0
+		}
+	}
+
+	pairedWith := make([]int, len(actual))
+	for j := range pairedWith {
+		pairedWith[j] = -1
+	}
+
+	var augment func(i int, visited []bool) bool
+	augment = func(i int, visited []bool) bool {
+		for j := range actual {
+			if !matches[i][j] || visited[j] {
+				continue
+			}
+			visited[j] = true
+			if pairedWith[j] < 0 || augment(pairedWith[j], visited) {
+				pairedWith[j] = i
+				return true
+			}
+		}
+		return false
+	}
+
+	var unmatched []int
+	for i := range expected {
+		if !augment(i, make([]bool, len(actual))) {
+			unmatched = append(unmatched, i)
+		}
+	}
+	return unmatched
+}
+
+// matchPlaceholder reports whether placeholder is a known placeholder and, if
+// so, whether value has the shape it stands for.
+func matchPlaceholder(placeholder string, value interface{}) (matched, isPlaceholder bool) {
+	text, isString := value.(string)
+
+	switch placeholder {
+	case AnyJSON:
+		return true, true
+	case UUIDJSON:
+		return isString && uuidPattern.MatchString(text), true
+	case RFC3339JSON:
+		if !isString {
+			return false, true
+		}
+		_, err := time.Parse(time.RFC3339Nano, text)
+		return err == nil, true
+	}
+
+	return false, false
+}
+
+func equalNumbers(expected, actual json.Number) bool {
+	if expected == actual {
+		return true
+	}
+
+	e, ok := new(big.Rat).SetString(expected.String())
+	if !ok {
+		return false
+	}
+	a, ok := new(big.Rat).SetString(actual.String())
+
+	return ok && e.Cmp(a) == 0
+}
+
+func sortedKeys(maps ...map[string]interface{}) []string {
+	seen := make(map[string]bool)
+	var keys []string
+	for _, m := range maps {
+		for key := range m {
+			if !seen[key] {
+				seen[key] = true
+				keys = append(keys, key)
+			}
+		}
+	}
+
+	sort.Strings(keys)
+	return keys
+}
+
+func escapePointer(token string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(token, "~", "~0"), "/", "~1")
+}
+
+func rootPointer(pointer string) string {
+	if pointer == "" {
+		return "(root)"
+	}
+
+	return pointer
+}
+
+func compactJSON(value interface{}) string {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+
+	return string(data)
+}
@@ -0,0 +1,165 @@
+package should
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestBeEqualJSON(t *testing.T) {
+	t.Run("scenarios that must fail tests", func(t *testing.T) {
+		assertThat := func(assumption string, expected, actual interface{}, expectedLogMessage string) {
+			stub := testingStub{}
+			should := New(&stub)
+
+			should.BeEqualJSON(expected, actual, assumption)
+
+			if !stub.hasFailed {
+				t.Error("test was expected to fail but did not")
+			}
+			if !stub.WasHelperCalled() {
+				t.Error("Helper() call was expected but did not happen")
+			}
+			if expectedLogMessage != stub.logMessage {
+				t.Errorf("wanted '%s' got '%s'", expectedLogMessage, stub.logMessage)
+			}
+		}
+
+		assertThat("should report differences as json pointers",
+			`{"items":[{"price":1},{"price":2}],"name":"a"}`,
+			`{"name":"b","items":[{"price":1},{"price":3}]}`,
+			"\nassumption: [ should report differences as json pointers ]\n    should: BeEqualJSON \n    reason: documents differ"+
+				"\n  expected: {\"items\":[{\"price\":1},{\"price\":2}],\"name\":\"a\"}"+
+				"\n    actual: {\"name\":\"b\",\"items\":[{\"price\":1},{\"price\":3}]}"+
+				"\n   differs: /items/1/price: expected 2, actual 3"+
+				"\n            /name: expected \"a\", actual \"b\"")
+		assertThat("should report missing and unexpected members",
+			[]byte(`{"a/b":[1,2],"c":true}`),
+			strings.NewReader(`{"a/b":[1],"d~":null}`),
+			"\nassumption: [ should report missing and unexpected members ]\n    should: BeEqualJSON \n    reason: documents differ"+
+				"\n  expected: {\"a/b\":[1,2],\"c\":true}"+
+				"\n    actual: {\"a/b\":[1],\"d~\":null}"+
+				"\n   differs: /a~1b/1: missing"+
+				"\n            /c: missing"+
+				"\n            /d~0: unexpected")
+		assertThat("should report type differences at the root",
+			`[]`, `{}`,
+			"\nassumption: [ should report type differences at the root ]\n    should: BeEqualJSON \n    reason: documents differ"+
+				"\n  expected: []\n    actual: {}\n   differs: (root): expected [], actual {}")
+		assertThat("should report invalid actual json",
+			`{}`, "{\n",
+			"\nassumption: [ should report invalid actual json ]\n    should: BeEqualJSON \n    reason: invalid actual JSON: unexpected EOF"+
+				"\n  expected: {}\n    actual: {\\n")
+		assertThat("should report trailing data as invalid expected json",
+			`{} {}`, `{}`,
+			"\nassumption: [ should report trailing data as invalid expected json ]\n    should: BeEqualJSON \n    reason: invalid expected JSON: unexpected data after top-level value"+
+				"\n  expected: {} {}\n    actual: {}")
+		assertThat("should report unsupported types",
+			42, `42`,
+			"\nassumption: [ should report unsupported types ]\n    should: BeEqualJSON \n    reason: invalid expected JSON: unsupported type int"+
+				"\n  expected: 42\n    actual: 42")
+	})
+
+	t.Run("scenarios that must not fail tests", func(t *testing.T) {
+		assertThat := func(assumption string, expected, actual interface{}) {
+			stub := testingStub{}
+			should := New(&stub)
+
+			should.BeEqualJSON(expected, actual, assumption)
+
+			if stub.hasFailed {
+				t.Error("test was expected to not fail but it did")
+			}
+			if stub.WasHelperCalled() {
+				t.Error("Helper() call was not expected")
+			}
+			if stub.logMessage != "" {
+				t.Errorf("wanted '%s' got '%s'", "", stub.logMessage)
+			}
+		}
+
+		assertThat("should not fail for different key order and whitespace",
+			`{"a":1,"b":[true,null]}`, "{\n\t\"b\": [ true, null ],\n\t\"a\": 1\n}")
+		assertThat("should not fail for equivalent numbers",
+			`{"price":1.50}`, []byte(`{"price":1.5e0}`))
+		assertThat("should not fail for readers",
+			bytes.NewBufferString(`"text"`), strings.NewReader(` "text" `))
+	})
+}
+
+func TestContainJSON(t *testing.T) {
+	t.Run("scenarios that must fail tests", func(t *testing.T) {
+		assertThat := func(assumption string, expected, actual interface{}, expectedLogMessage string) {
+			stub := testingStub{}
+			should := New(&stub)
+
+			should.ContainJSON(expected, actual, assumption)
+
+			if !stub.hasFailed {
+				t.Error("test was expected to fail but did not")
+			}
+			if !stub.WasHelperCalled() {
+				t.Error("Helper() call was expected but did not happen")
+			}
+			if expectedLogMessage != stub.logMessage {
+				t.Errorf("wanted '%s' got '%s'", expectedLogMessage, stub.logMessage)
+			}
+		}
+
+		assertThat("should report missing members and placeholder mismatches",
+			`{"id":"<<UUID>>","created":"<<RFC3339>>","owner":"<<ANY>>","name":"a"}`,
+			`{"id":42,"created":"yesterday","name":"b"}`,
+			"\nassumption: [ should report missing members and placeholder mismatches ]\n    should: ContainJSON \n    reason: fragment not found"+
+				"\n  expected: {\"id\":\"<<UUID>>\",\"created\":\"<<RFC3339>>\",\"owner\":\"<<ANY>>\",\"name\":\"a\"}"+
+				"\n    actual: {\"id\":42,\"created\":\"yesterday\",\"name\":\"b\"}"+
+				"\n   differs: /created: expected <<RFC3339>>, actual \"yesterday\""+
+				"\n            /id: expected <<UUID>>, actual 42"+
+				"\n            /name: expected \"a\", actual \"b\""+
+				"\n            /owner: missing")
+		assertThat("should report array elements without a match",
+			`{"items":[{"sku":"a"},{"sku":"a"}]}`,
+			`{"items":[{"sku":"a","qty":1},{"sku":"b"}]}`,
+			"\nassumption: [ should report array elements without a match ]\n    should: ContainJSON \n    reason: fragment not found"+
+				"\n  expected: {\"items\":[{\"sku\":\"a\"},{\"sku\":\"a\"}]}"+
+				"\n    actual: {\"items\":[{\"sku\":\"a\",\"qty\":1},{\"sku\":\"b\"}]}"+
+				"\n   differs: /items/1: no element matches {\"sku\":\"a\"}")
+		assertThat("should report elements left without a distinct match",
+			`["<<ANY>>", 1, 1]`, `[1, 2]`,
+			"\nassumption: [ should report elements left without a distinct match ]\n    should: ContainJSON \n    reason: fragment not found"+
+				"\n  expected: [\"<<ANY>>\", 1, 1]\n    actual: [1, 2]\n   differs: /2: no element matches 1")
+		assertThat("should report invalid expected json",
+			`{"id":`, `{}`,
+			"\nassumption: [ should report invalid expected json ]\n    should: ContainJSON \n    reason: invalid expected JSON: unexpected EOF"+
+				"\n  expected: {\"id\":\n    actual: {}")
+	})
+
+	t.Run("scenarios that must not fail tests", func(t *testing.T) {
+		assertThat := func(assumption string, expected, actual interface{}) {
+			stub := testingStub{}
+			should := New(&stub)
+
+			should.ContainJSON(expected, actual, assumption)
+
+			if stub.hasFailed {
+				t.Error("test was expected to not fail but it did")
+			}
+			if stub.WasHelperCalled() {
+				t.Error("Helper() call was not expected")
+			}
+			if stub.logMessage != "" {
+				t.Errorf("wanted '%s' got '%s'", "", stub.logMessage)
+			}
+		}
+
+		assertThat("should not fail for fragment with placeholders",
+			`{"id":"<<UUID>>","created":"<<RFC3339>>","owner":"<<ANY>>"}`,
+			`{"id":"6ba7b810-9dad-11d1-80b4-00c04fd430c8","created":"2020-01-02T03:04:05.123Z","owner":null,"extra":1}`)
+		assertThat("should not fail for array elements in any order",
+			`{"items":[{"sku":"b"},{"sku":"a"}]}`,
+			strings.NewReader(`{"items":[{"sku":"a","qty":1},{"sku":"c"},{"sku":"b","qty":2}]}`))
+		assertThat("should not fail for placeholders before stricter elements",
+			`["<<ANY>>", 1]`, `[1, 2]`)
+		assertThat("should not fail for looser elements before stricter ones",
+			`[{"id":"<<ANY>>"},{"id":1}]`, `[{"id":1},{"id":2}]`)
+	})
+}
@@ -0,0 +1,314 @@
+package should
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+var (
+	// defaultJUnit receives the calls of every Should created while RunWithJUnit runs the tests.
+	defaultJUnit *JUnitReporter
+
+	// junitClock and junitHostname provide the timestamp and hostname of JUnit test suites.
+	junitClock    = time.Now
+	junitHostname = os.Hostname
+)
+
+// Option configures a Should instance.
+type Option func(*Should)
+
+// WithJUnit reports every assertion made through the Should instance to r, as a JUnit test case.
+func WithJUnit(r *JUnitReporter) Option {
+	return func(s *Should) {
+		s.junit = r
+	}
+}
+
+// JUnitReporter collects assertions as JUnit test cases, grouped in one test suite per test.
+// Test cases are named after the assumption, or after the source of the assertion when none
+// was given, so that they keep the same name whether they pass or fail. It is safe for concurrent use.
+type JUnitReporter struct {
+	mu     sync.Mutex
+	suites []*junitSuiteRecord
+}
+
+type junitSuiteRecord struct {
+	name    string
+	started time.Time
+	calls   []junitCall
+}
+
+type junitCall struct {
+	name      string
+	assertion string
+	message   string
+	failed    bool
+	duration  time.Duration
+}
+
+// NewJUnitReporter initialises a new, empty JUnitReporter.
+func NewJUnitReporter() *JUnitReporter {
+	return &JUnitReporter{}
+}
+
+// RunWithJUnit runs the tests, reporting every assertion made through Should to a JUnit XML file at path.
+// It is meant to be called from TestMain:
+//
+//	func TestMain(m *testing.M) {
+//		os.Exit(should.RunWithJUnit(m, "should-junit.xml"))
+//	}
+func RunWithJUnit(m interface{ Run() int }, path string) int {
+	r := NewJUnitReporter()
+	reportMu.Lock()
+	defaultJUnit = r
+	reportMu.Unlock()
+
+	defer func() {
+		reportMu.Lock()
+		defaultJUnit = nil
+		reportMu.Unlock()
+	}()
+
+	code := m.Run()
+	if err := r.WriteFile(path); err != nil {
+		fmt.Fprintf(reportOutput, "should: writing JUnit report: %v\n", err)
+		if code == 0 {
+			code = 1
+		}
+	}
+	return code
+}
+
+func (r *JUnitReporter) add(test string, call junitCall) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, suite := range r.suites {
+		if suite.name == test {
+			suite.calls = append(suite.calls, call)
+			return
+		}
+	}
+	r.suites = append(r.suites, &junitSuiteRecord{name: test, started: junitClock(), calls: []junitCall{call}})
+}
+
+// WriteTo writes the JUnit XML report to w.
+func (r *JUnitReporter) WriteTo(w io.Writer) (int64, error) {
+	r.mu.Lock()
+	suites := make([]junitTestSuite, len(r.suites))
+	for i, record := range r.suites {
+		suites[i] = newJUnitSuite(i, record.name, record.started)
+		for _, call := range record.calls {
+			testCase := junitTestCase{Name: call.name, ClassName: record.name, Time: junitSeconds(call.duration)}
+			if call.failed {
+				testCase.Failure = &junitFailure{Message: call.name, Type: call.assertion, Text: call.message}
+				suites[i].Failures++
+			}
+			suites[i].Cases = append(suites[i].Cases, testCase)
+			suites[i].duration += call.duration
+		}
+		suites[i].Tests = len(suites[i].Cases)
+		suites[i].Time = junitSeconds(suites[i].duration)
+	}
+	r.mu.Unlock()
+
+	data, err := marshalJUnit(suites)
+	if err != nil {
+		return 0, err
+	}
+
+	n, err := io.Copy(w, bytes.NewReader(data))
+	return n, err
+}
+
+// WriteFile writes the JUnit XML report to path, creating its directory if needed.
+func (r *JUnitReporter) WriteFile(path string) error {
+	var b strings.Builder
+	if _, err := r.WriteTo(&b); err != nil {
+		return err
+	}
+
+	return writeFile(path, b.String())
+}
+
+// junitT counts the failures of a Should instance, so its calls can be reported as passed or failed.
+type junitT struct {
+	TestingT
+	mu       sync.Mutex
+	pending  []string
+	failures int
+	message  string
+}
+
+func (t *junitT) Log(args ...interface{}) {
+	t.TestingT.Helper()
+
+	t.mu.Lock()
+	t.pending = append(t.pending, fmt.Sprint(args...))
+	t.mu.Unlock()
+
+	t.TestingT.Log(args...)
+}
+
+func (t *junitT) Fail() {
+	t.TestingT.Helper()
+
+	t.mu.Lock()
+	t.failures++
+	t.message = strings.Join(t.pending, "\n")
+	t.pending = nil
+	t.mu.Unlock()
+
+	t.TestingT.Fail()
+}
+
+func (t *junitT) discardPending() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.pending = nil
+}
+
+func (t *junitT) unwrap() TestingT {
+	return t.TestingT
+}
+
+func (t *junitT) state() (int, string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.failures, t.message
+}
+
+// track tells the wrappers of s which assertion their failures belong to, and reports the
+// assertion method to the JUnit reporter of s, if any, once it completes.
+// It is meant to be deferred at the start of every assertion:
+//
+//	defer s.track("BeEqual", assumption)()
+func (s *Should) track(method string, assumption []string) func() {
+	done := asserting(s.t, method, s.assumed(assumption))
+	counter, ok := s.junitCounter()
+	if !ok {
+		return done
+	}
+
+	start := junitClock()
+	before, _ := counter.state()
+	name := strings.TrimSpace(strings.Join(s.assumed(assumption), " "))
+	if name == "" {
+		name = describeCall(method)
+	}
+
+	return func() {
+		after, message := counter.state()
+		call := junitCall{name: name, assertion: method, duration: junitClock().Sub(start)}
+		if after > before {
+			call.failed, call.message = true, message
+		}
+		s.junit.add(testName(s.t), call)
+		done()
+	}
+}
+
+// junitCounter returns the junitT of s, when it reports to a JUnitReporter.
+func (s *Should) junitCounter() (*junitT, bool) {
+	if s.junit == nil {
+		return nil, false
+	}
+
+	t, ok := capability(s.t, func(t TestingT) bool { _, ok := t.(*junitT); return ok })
+	if !ok {
+		return nil, false
+	}
+	return t.(*junitT), true
+}
+
+// describeCall returns the source of the assertion call, e.g. "BeEqual(42, calc.Sum(40, 2))",
+// or the method name when the source is not available.
+func describeCall(method string) string {
+	file, line, ok := callerLocation()
+	if !ok {
+		return method
+	}
+
+	args, ok := callArguments(file, line, method)
+	if !ok {
+		return method
+	}
+	return method + "(" + strings.Join(args, ", ") + ")"
+}
+
+func testName(t TestingT) string {
+	if n, ok := capability(t, func(t TestingT) bool { _, ok := t.(NameT); return ok }); ok {
+		return n.(NameT).Name()
+	}
+	return "(unknown test)"
+}
+
+type junitTestSuites struct {
+	XMLName xml.Name         `xml:"testsuites"`
+	Suites  []junitTestSuite `xml:"testsuite"`
+}
+
+// junitTestSuite follows the JUnit XML schema, as consumed by Jenkins and most CI servers.
+type junitTestSuite struct {
+	Package    string          `xml:"package,attr"`
+	ID         int             `xml:"id,attr"`
+	Name       string          `xml:"name,attr"`
+	Timestamp  string          `xml:"timestamp,attr"`
+	Hostname   string          `xml:"hostname,attr"`
+	Tests      int             `xml:"tests,attr"`
+	Failures   int             `xml:"failures,attr"`
+	Errors     int             `xml:"errors,attr"`
+	Time       string          `xml:"time,attr"`
+	Properties struct{}        `xml:"properties"`
+	Cases      []junitTestCase `xml:"testcase"`
+	SystemOut  string          `xml:"system-out"`
+	SystemErr  string          `xml:"system-err"`
+
+	duration time.Duration
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Time      string        `xml:"time,attr"`
+	Failure   *junitFailure `xml:"failure,omitempty"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr"`
+	Text    string `xml:",chardata"`
+}
+
+func newJUnitSuite(id int, name string, started time.Time) junitTestSuite {
+	hostname, err := junitHostname()
+	if err != nil {
+		hostname = "localhost"
+	}
+
+	return junitTestSuite{
+		ID:        id,
+		Name:      name,
+		Timestamp: started.UTC().Format("2006-01-02T15:04:05"),
+		Hostname:  hostname,
+		Time:      junitSeconds(0),
+	}
+}
+
+func junitSeconds(d time.Duration) string {
+	return fmt.Sprintf("%.3f", d.Seconds())
+}
+
+func marshalJUnit(suites []junitTestSuite) ([]byte, error) {
+	data, err := xml.MarshalIndent(junitTestSuites{Suites: suites}, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), append(data, '\n')...), nil
+}
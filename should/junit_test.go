@@ -0,0 +1,125 @@
+package should
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// fixJUnitEnvironment makes the timestamps, durations and hostname of JUnit reports predictable.
+func fixJUnitEnvironment(t *testing.T) {
+	clock, hostname := junitClock, junitHostname
+	t.Cleanup(func() { junitClock, junitHostname = clock, hostname })
+
+	junitClock = func() time.Time { return time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC) }
+	junitHostname = func() (string, error) { return "ci-runner", nil }
+}
+
+func TestWithJUnit(t *testing.T) {
+	fixJUnitEnvironment(t)
+
+	r := NewJUnitReporter()
+	stub := &namedStub{name: "TestSum"}
+	s := New(stub, WithJUnit(r))
+	s.BeEqual(42, 42, "sum equals 42")
+	s.BeTrue(false)
+	New(&namedStub{name: "TestName"}, WithJUnit(r)).HavePrefix("na", "name", "name is prefixed")
+
+	var out bytes.Buffer
+	_, err := r.WriteTo(&out)
+
+	expected := `<?xml version="1.0" encoding="UTF-8"?>
+<testsuites>
+  <testsuite package="" id="0" name="TestSum" timestamp="2024-01-02T03:04:05" hostname="ci-runner" tests="2" failures="1" errors="0" time="0.000">
+    <properties></properties>
+    <testcase name="sum equals 42" classname="TestSum" time="0.000"></testcase>
+    <testcase name="BeTrue(false)" classname="TestSum" time="0.000">
+      <failure message="BeTrue(false)" type="BeTrue">&#xA;assumption: [ false is true ]&#xA;    should: BeTrue &#xA;  expected: true&#xA;    actual: false</failure>
+    </testcase>
+    <system-out></system-out>
+    <system-err></system-err>
+  </testsuite>
+  <testsuite package="" id="1" name="TestName" timestamp="2024-01-02T03:04:05" hostname="ci-runner" tests="1" failures="0" errors="0" time="0.000">
+    <properties></properties>
+    <testcase name="name is prefixed" classname="TestName" time="0.000"></testcase>
+    <system-out></system-out>
+    <system-err></system-err>
+  </testsuite>
+</testsuites>
+`
+	if err != nil || out.String() != expected {
+		t.Errorf("wanted '%s' got '%s' (%v)", expected, out.String(), err)
+	}
+	if !stub.hasFailed {
+		t.Error("failures should still be passed on to the test")
+	}
+}
+
+func TestRunWithJUnit(t *testing.T) {
+	t.Run("writes every assertion to the report", func(t *testing.T) {
+		fixJUnitEnvironment(t)
+		path := filepath.Join(t.TempDir(), "reports", "junit.xml")
+
+		code := RunWithJUnit(mainStub(func() int {
+			New(&namedStub{name: "TestSplit"}).BeEqual(2, len([]string{"a", "b"}), "split returns two parts")
+			NewFuzz(&namedStub{name: "FuzzSplit"}, "a,b").BeFalse(true, "split is empty")
+			return 1
+		}), path)
+
+		content, err := ioutil.ReadFile(path)
+		expected := `<?xml version="1.0" encoding="UTF-8"?>
+<testsuites>
+  <testsuite package="" id="0" name="TestSplit" timestamp="2024-01-02T03:04:05" hostname="ci-runner" tests="1" failures="0" errors="0" time="0.000">
+    <properties></properties>
+    <testcase name="split returns two parts" classname="TestSplit" time="0.000"></testcase>
+    <system-out></system-out>
+    <system-err></system-err>
+  </testsuite>
+  <testsuite package="" id="1" name="FuzzSplit" timestamp="2024-01-02T03:04:05" hostname="ci-runner" tests="1" failures="1" errors="0" time="0.000">
+    <properties></properties>
+    <testcase name="split is empty" classname="FuzzSplit" time="0.000">
+      <failure message="split is empty" type="BeFalse">&#xA;assumption: [ split is empty ]&#xA;    should: BeFalse &#xA;  expected: false&#xA;    actual: true&#xA;fuzz input: &#34;a,b&#34;</failure>
+    </testcase>
+    <system-out></system-out>
+    <system-err></system-err>
+  </testsuite>
+</testsuites>
+`
+		if code != 1 {
+			t.Errorf("wanted exit code 1 got %d", code)
+		}
+		if err != nil || string(content) != expected {
+			t.Errorf("wanted '%s' got '%s' (%v)", expected, content, err)
+		}
+	})
+
+	t.Run("fails when the report cannot be written", func(t *testing.T) {
+		var out bytes.Buffer
+		defer func(original io.Writer) { reportOutput = original }(reportOutput)
+		reportOutput = &out
+		path := filepath.Join(t.TempDir(), "junit.xml")
+		if err := ioutil.WriteFile(path, nil, 0600); err != nil {
+			t.Fatal(err)
+		}
+
+		code := RunWithJUnit(mainStub(func() int { return 0 }), filepath.Join(path, "junit.xml"))
+
+		if code != 1 {
+			t.Errorf("wanted exit code 1 got %d", code)
+		}
+		if out.Len() == 0 {
+			t.Error("wanted the error to be printed")
+		}
+	})
+
+	t.Run("stops reporting once the tests ran", func(t *testing.T) {
+		RunWithJUnit(mainStub(func() int { return 0 }), filepath.Join(t.TempDir(), "junit.xml"))
+
+		if s := New(&testingStub{}); s.junit != nil {
+			t.Error("wanted no reporter after RunWithJUnit returned")
+		}
+	})
+}
@@ -0,0 +1,140 @@
+package should
+
+import (
+	"fmt"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const leakLogFormat string = "\nassumption: [ %s ]\n    should: %s \n    reason: %s\n  expected: %v\n    actual: %v\n    leaked: %s"
+
+// leakTimeout is how long goroutines that are still exiting are waited for
+// before they are reported as leaked.
+var leakTimeout = time.Second
+
+// ignoredGoroutines holds stack fragments of goroutines started by the
+// runtime and the testing package, which are never reported as leaks.
+var ignoredGoroutines = []string{
+	"testing.tRunner",
+	"testing.(*T).Run",
+	"testing.(*M).",
+	"testing.runTests",
+	"testing.runFuzzing",
+	"os/signal.signal_recv",
+	"os/signal.loop",
+	"runtime.ensureSigM",
+	"runtime/trace.Start",
+}
+
+// NotLeakGoroutines runs fn and fails the test if goroutines started while it ran are still alive after it returns.
+func (s *Should) NotLeakGoroutines(fn func(), assumption ...string) {
+	defer s.track("NotLeakGoroutines", assumption)()
+
+	before := goroutineStacks()
+	fn()
+
+	if leaked := leakedGoroutines(before); len(leaked) > 0 {
+		s.t.Helper()
+		s.t.Log(fmt.Sprintf(leakLogFormat, describe(s.assumed(assumption), "NotLeakGoroutines", "no goroutines leak"), "NotLeakGoroutines",
+			"goroutines still running", 0, len(leaked), strings.ReplaceAll(strings.Join(leaked, "\n"), "\n", differencesIndent)))
+		s.t.Fail()
+	}
+}
+
+// VerifyNoLeaks snapshots the running goroutines and returns a function that fails the test
+// if any goroutine started since is still alive. It is meant to be deferred at the start of a test:
+//
+//	defer should.VerifyNoLeaks()()
+func (s *Should) VerifyNoLeaks(assumption ...string) func() {
+	before := goroutineStacks()
+	done := s.track("VerifyNoLeaks", assumption)
+
+	return func() {
+		defer done()
+
+		if leaked := leakedGoroutines(before); len(leaked) > 0 {
+			s.t.Helper()
+			s.t.Log(fmt.Sprintf(leakLogFormat, describe(s.assumed(assumption), "VerifyNoLeaks", "no goroutines leak"), "VerifyNoLeaks",
+				"goroutines still running", 0, len(leaked), strings.ReplaceAll(strings.Join(leaked, "\n"), "\n", differencesIndent)))
+			s.t.Fail()
+		}
+	}
+}
+
+// leakedGoroutines returns the stacks of goroutines that are not in before,
+// retrying with a growing backoff until leakTimeout to let them exit.
+func leakedGoroutines(before map[int]string) []string {
+	deadline := time.Now().Add(leakTimeout)
+	backoff := time.Millisecond
+
+	for {
+		var ids []int
+		current := goroutineStacks()
+		for id, stack := range current {
+			if _, existed := before[id]; !existed && !isIgnoredGoroutine(stack) {
+				ids = append(ids, id)
+			}
+		}
+
+		sort.Ints(ids)
+		leaked := make([]string, len(ids))
+		for i, id := range ids {
+			leaked[i] = current[id]
+		}
+
+		if len(leaked) == 0 || time.Now().After(deadline) {
+			return leaked
+		}
+
+		time.Sleep(backoff)
+		if backoff < 100*time.Millisecond {
+			backoff *= 2
+		}
+	}
+}
+
+// goroutineStacks returns the stack of every goroutine but the calling one, keyed by goroutine id.
+func goroutineStacks() map[int]string {
+	buf := make([]byte, 64*1024)
+	for {
+		n := runtime.Stack(buf, true)
+		if n < len(buf) {
+			buf = buf[:n]
+			break
+		}
+		buf = make([]byte, 2*len(buf))
+	}
+
+	stacks := make(map[int]string)
+	for i, stack := range strings.Split(string(buf), "\n\n") {
+		if i == 0 {
+			// The first stack always belongs to the calling goroutine.
+			continue
+		}
+
+		header := strings.TrimPrefix(stack, "goroutine ")
+		end := strings.Index(header, " ")
+		if end < 0 {
+			continue
+		}
+
+		if id, err := strconv.Atoi(header[:end]); err == nil {
+			stacks[id] = strings.TrimSpace(stack)
+		}
+	}
+
+	return stacks
+}
+
+func isIgnoredGoroutine(stack string) bool {
+	for _, ignored := range ignoredGoroutines {
+		if strings.Contains(stack, ignored) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,83 @@
+package should
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNotLeakGoroutines(t *testing.T) {
+	defer func(timeout time.Duration) { leakTimeout = timeout }(leakTimeout)
+	leakTimeout = 20 * time.Millisecond
+
+	t.Run("scenarios that must fail tests", func(t *testing.T) {
+		assertThat := func(assumption string, check func(*Should, chan struct{}), expectedLogMessages ...string) {
+			stub := testingStub{}
+			should := New(&stub)
+			release := make(chan struct{})
+			defer close(release)
+
+			check(should, release)
+
+			if !stub.hasFailed {
+				t.Error("test was expected to fail but did not")
+			}
+			if !stub.WasHelperCalled() {
+				t.Error("Helper() call was expected but did not happen")
+			}
+			for _, expected := range expectedLogMessages {
+				if !strings.Contains(stub.logMessage, expected) {
+					t.Errorf("wanted log containing '%s' got '%s'", expected, stub.logMessage)
+				}
+			}
+		}
+
+		assertThat("should fail for goroutines left running",
+			func(s *Should, release chan struct{}) {
+				s.NotLeakGoroutines(func() {
+					go func() { <-release }()
+				}, "worker stops")
+			},
+			"\nassumption: [ worker stops ]\n    should: NotLeakGoroutines \n    reason: goroutines still running\n  expected: 0\n    actual: 1\n    leaked: goroutine ",
+			"[chan receive]:",
+			"created by github.com/pjbgf/go-test/should.TestNotLeakGoroutines")
+		assertThat("should fail for goroutines left running when deferred",
+			func(s *Should, release chan struct{}) {
+				verify := s.VerifyNoLeaks()
+				go func() { <-release }()
+				verify()
+			},
+			"\nassumption: [ no goroutines leak ]\n    should: VerifyNoLeaks \n    reason: goroutines still running\n  expected: 0\n    actual: 1\n")
+	})
+
+	t.Run("scenarios that must not fail tests", func(t *testing.T) {
+		assertThat := func(assumption string, check func(*Should)) {
+			stub := testingStub{}
+			should := New(&stub)
+
+			check(should)
+
+			if stub.hasFailed {
+				t.Error("test was expected to not fail but it did")
+			}
+			if stub.WasHelperCalled() {
+				t.Error("Helper() call was not expected")
+			}
+			if stub.logMessage != "" {
+				t.Errorf("wanted '%s' got '%s'", "", stub.logMessage)
+			}
+		}
+
+		assertThat("should not fail for goroutines that finish", func(s *Should) {
+			s.NotLeakGoroutines(func() {
+				done := make(chan struct{})
+				go func() { close(done) }()
+				<-done
+			})
+		})
+		assertThat("should not fail for goroutines that are still exiting", func(s *Should) {
+			defer s.VerifyNoLeaks()()
+			go func() { time.Sleep(5 * time.Millisecond) }()
+		})
+	})
+}
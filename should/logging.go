@@ -0,0 +1,230 @@
+package should
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"log/slog"
+	"strings"
+	"sync"
+)
+
+const capturedLogFormat string = "\nassumption: [ %s ]\n    should: %s \n  expected: %v\n    actual: %v\n  captured: %s"
+
+// LogRecord is a message captured by CaptureLog. Messages written through the log package
+// have no attributes and are recorded at slog.LevelInfo.
+type LogRecord struct {
+	Level   slog.Level
+	Message string
+	Attrs   []slog.Attr
+}
+
+// String formats r as a line of text, e.g. `WARN "retrying" attempt=2`.
+func (r LogRecord) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s %q", r.Level, r.Message)
+	for _, attr := range r.Attrs {
+		fmt.Fprintf(&b, " %s=%s", attr.Key, attr.Value)
+	}
+	return b.String()
+}
+
+// CapturedLog holds the records captured by CaptureLog.
+type CapturedLog struct {
+	mu      sync.Mutex
+	records []LogRecord
+}
+
+// CaptureLog runs fn and captures everything it logs through the log package and through the default
+// slog.Logger. Both are global, so tests capturing logs must not run in parallel with tests that log.
+//
+//	logs := should.CaptureLog(func() { server.Start() })
+//	s.HaveLogged(logs, slog.LevelInfo, "listening")
+func CaptureLog(fn func()) *CapturedLog {
+	c := &CapturedLog{}
+
+	writer, flags, prefix := log.Writer(), log.Flags(), log.Prefix()
+	previous := slog.Default()
+	defer func() {
+		slog.SetDefault(previous)
+		log.SetOutput(writer)
+		log.SetFlags(flags)
+		log.SetPrefix(prefix)
+	}()
+
+	slog.SetDefault(slog.New(&captureHandler{log: c}))
+	log.SetOutput(logWriter{c})
+	log.SetFlags(0)
+	log.SetPrefix("")
+
+	fn()
+	return c
+}
+
+// Records returns every record captured, in order.
+func (c *CapturedLog) Records() []LogRecord {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]LogRecord(nil), c.records...)
+}
+
+func (c *CapturedLog) add(r LogRecord) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.records = append(c.records, r)
+}
+
+// HaveLogged fails the test if no record at exactly level has a message containing substr.
+func (s *Should) HaveLogged(logs *CapturedLog, level slog.Level, substr string, assumption ...string) {
+	defer s.track("HaveLogged", assumption)()
+
+	records := logs.Records()
+	for _, r := range records {
+		if r.Level == level && strings.Contains(r.Message, substr) {
+			return
+		}
+	}
+
+	s.t.Helper()
+	s.t.Log(fmt.Sprintf(capturedLogFormat, describe(s.assumed(assumption), "HaveLogged", "%[2]s record containing %[3]s is logged"), "HaveLogged",
+		fmt.Sprintf("%s record containing %q", level, substr), "no matching record", formatRecords(records)))
+	s.t.Fail()
+}
+
+// HaveLoggedAttrs fails the test if no record has all of attrs. Keys of attributes within
+// groups are prefixed by the group names, e.g. "request.id". Use Because to describe the assertion.
+func (s *Should) HaveLoggedAttrs(logs *CapturedLog, attrs ...slog.Attr) {
+	defer s.track("HaveLoggedAttrs", nil)()
+
+	records := logs.Records()
+	for _, r := range records {
+		if hasAttrs(r, attrs) {
+			return
+		}
+	}
+
+	expected := make([]string, len(attrs))
+	for i, attr := range attrs {
+		expected[i] = attr.String()
+	}
+
+	record := "record with " + strings.Join(expected, " ")
+	s.t.Helper()
+	s.t.Log(fmt.Sprintf(capturedLogFormat, describe(s.because, "HaveLoggedAttrs", record+" is logged"), "HaveLoggedAttrs",
+		record, "no matching record", formatRecords(records)))
+	s.t.Fail()
+}
+
+// NotHaveLogged fails the test if any record is at level or above.
+func (s *Should) NotHaveLogged(logs *CapturedLog, level slog.Level, assumption ...string) {
+	defer s.track("NotHaveLogged", assumption)()
+
+	records := logs.Records()
+	matched := 0
+	for _, r := range records {
+		if r.Level >= level {
+			matched++
+		}
+	}
+
+	if matched > 0 {
+		s.t.Helper()
+		s.t.Log(fmt.Sprintf(capturedLogFormat, describe(s.assumed(assumption), "NotHaveLogged", "nothing is logged at %[2]s or above"), "NotHaveLogged",
+			fmt.Sprintf("no record at %s or above", level), plural(matched, "matching record"), formatRecords(records)))
+		s.t.Fail()
+	}
+}
+
+func hasAttrs(r LogRecord, attrs []slog.Attr) bool {
+	for _, want := range attrs {
+		found := false
+		for _, attr := range r.Attrs {
+			if attr.Equal(want) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+func formatRecords(records []LogRecord) string {
+	lines := make([]string, len(records))
+	for i, r := range records {
+		lines[i] = r.String()
+	}
+	return listOrNone(lines)
+}
+
+// logWriter records every line written by the log package.
+type logWriter struct {
+	log *CapturedLog
+}
+
+func (w logWriter) Write(p []byte) (int, error) {
+	w.log.add(LogRecord{Level: slog.LevelInfo, Message: strings.TrimSuffix(string(p), "\n")})
+	return len(p), nil
+}
+
+// captureHandler is a slog.Handler recording every record at every level.
+type captureHandler struct {
+	log    *CapturedLog
+	attrs  []slog.Attr
+	groups string
+}
+
+func (h *captureHandler) Enabled(context.Context, slog.Level) bool {
+	return true
+}
+
+func (h *captureHandler) Handle(_ context.Context, record slog.Record) error {
+	attrs := append([]slog.Attr(nil), h.attrs...)
+	record.Attrs(func(attr slog.Attr) bool {
+		attrs = appendAttr(attrs, h.groups, attr)
+		return true
+	})
+
+	h.log.add(LogRecord{Level: record.Level, Message: record.Message, Attrs: attrs})
+	return nil
+}
+
+func (h *captureHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	derived := *h
+	derived.attrs = append([]slog.Attr(nil), h.attrs...)
+	for _, attr := range attrs {
+		derived.attrs = appendAttr(derived.attrs, h.groups, attr)
+	}
+	return &derived
+}
+
+func (h *captureHandler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return h
+	}
+
+	derived := *h
+	derived.groups = h.groups + name + "."
+	return &derived
+}
+
+// appendAttr appends attr to attrs, flattening groups into keys prefixed by the group names.
+func appendAttr(attrs []slog.Attr, prefix string, attr slog.Attr) []slog.Attr {
+	attr.Value = attr.Value.Resolve()
+	if attr.Value.Kind() != slog.KindGroup {
+		if attr.Equal(slog.Attr{}) {
+			return attrs
+		}
+		return append(attrs, slog.Attr{Key: prefix + attr.Key, Value: attr.Value})
+	}
+
+	if attr.Key != "" {
+		prefix += attr.Key + "."
+	}
+	for _, member := range attr.Value.Group() {
+		attrs = appendAttr(attrs, prefix, member)
+	}
+	return attrs
+}
@@ -0,0 +1,122 @@
+package should
+
+import (
+	"bytes"
+	"log"
+	"log/slog"
+	"testing"
+)
+
+func TestCaptureLog(t *testing.T) {
+	logs := CaptureLog(func() {
+		log.Printf("starting on port %d", 8080)
+		slog.Info("listening", "port", 8080)
+		slog.With("request", "r1").WithGroup("user").Warn("denied", slog.String("id", "u1"), slog.Group("role", "name", "guest"))
+		slog.Debug("verbose")
+	})
+
+	t.Run("captures log and slog records", func(t *testing.T) {
+		expected := []string{
+			`INFO "starting on port 8080"`,
+			`INFO "listening" port=8080`,
+			`WARN "denied" request=r1 user.id=u1 user.role.name=guest`,
+			`DEBUG "verbose"`,
+		}
+
+		records := logs.Records()
+		if len(records) != len(expected) {
+			t.Fatalf("wanted %d records got %q", len(expected), records)
+		}
+		for i, r := range records {
+			if r.String() != expected[i] {
+				t.Errorf("wanted '%s' got '%s'", expected[i], r.String())
+			}
+		}
+	})
+
+	t.Run("restores the loggers", func(t *testing.T) {
+		var out bytes.Buffer
+		writer := log.Writer()
+		defer log.SetOutput(writer)
+		log.SetOutput(&out)
+
+		CaptureLog(func() {})
+		log.Print("after")
+
+		if out.Len() == 0 {
+			t.Error("wanted the log package to write to its previous output")
+		}
+		if _, captured := slog.Default().Handler().(*captureHandler); captured {
+			t.Error("wanted the previous slog.Logger to be restored")
+		}
+	})
+
+	captured := `INFO "starting on port 8080"` +
+		"\n            " + `INFO "listening" port=8080` +
+		"\n            " + `WARN "denied" request=r1 user.id=u1 user.role.name=guest` +
+		"\n            " + `DEBUG "verbose"`
+
+	t.Run("scenarios that must fail tests", func(t *testing.T) {
+		assertThat := func(assumption string, check func(*Should), expectedLogMessage string) {
+			stub := testingStub{}
+			should := New(&stub)
+
+			check(should)
+
+			if !stub.hasFailed {
+				t.Error("test was expected to fail but did not")
+			}
+			if !stub.WasHelperCalled() {
+				t.Error("Helper() call was expected but did not happen")
+			}
+			if expectedLogMessage != stub.logMessage {
+				t.Errorf("wanted '%s' got '%s'", expectedLogMessage, stub.logMessage)
+			}
+		}
+
+		assertThat("should fail when no message matches",
+			func(s *Should) { s.HaveLogged(logs, slog.LevelError, "denied", "denial is logged") },
+			"\nassumption: [ denial is logged ]\n    should: HaveLogged \n  expected: ERROR record containing \"denied\"\n    actual: no matching record\n  captured: "+captured)
+		assertThat("should fail when no record has all attributes",
+			func(s *Should) {
+				s.Because("request is logged").HaveLoggedAttrs(logs, slog.Int("port", 8080), slog.String("request", "r1"))
+			},
+			"\nassumption: [ request is logged ]\n    should: HaveLoggedAttrs \n  expected: record with port=8080 request=r1\n    actual: no matching record\n  captured: "+captured)
+		assertThat("should derive the assumption from the attributes",
+			func(s *Should) { s.HaveLoggedAttrs(logs, slog.Int("port", 9090)) },
+			"\nassumption: [ record with port=9090 is logged ]\n    should: HaveLoggedAttrs \n  expected: record with port=9090\n    actual: no matching record\n  captured: "+captured)
+		assertThat("should fail when records are at the level or above",
+			func(s *Should) { s.NotHaveLogged(logs, slog.LevelInfo, "nothing is logged") },
+			"\nassumption: [ nothing is logged ]\n    should: NotHaveLogged \n  expected: no record at INFO or above\n    actual: 3 matching records\n  captured: "+captured)
+		assertThat("should report when nothing was captured",
+			func(s *Should) { s.HaveLogged(&CapturedLog{}, slog.LevelInfo, "started", "start is logged") },
+			"\nassumption: [ start is logged ]\n    should: HaveLogged \n  expected: INFO record containing \"started\"\n    actual: no matching record\n  captured: none")
+	})
+
+	t.Run("scenarios that must not fail tests", func(t *testing.T) {
+		assertThat := func(assumption string, check func(*Should)) {
+			stub := testingStub{}
+			should := New(&stub)
+
+			check(should)
+
+			if stub.hasFailed {
+				t.Error("test was expected to not fail but it did")
+			}
+			if stub.WasHelperCalled() {
+				t.Error("Helper() call was not expected but it happened")
+			}
+		}
+
+		assertThat("should pass for messages from the log package",
+			func(s *Should) { s.HaveLogged(logs, slog.LevelInfo, "port 8080") })
+		assertThat("should pass for messages from slog",
+			func(s *Should) { s.HaveLogged(logs, slog.LevelWarn, "denied") })
+		assertThat("should pass for attributes within groups",
+			func(s *Should) {
+				s.HaveLoggedAttrs(logs, slog.String("request", "r1"), slog.String("user.role.name", "guest"))
+			})
+		assertThat("should pass when nothing is at the level or above",
+			func(s *Should) { s.NotHaveLogged(logs, slog.LevelError) })
+	})
+}
@@ -0,0 +1,58 @@
+package mock
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// Matcher decides whether an argument satisfies an expectation.
+type Matcher interface {
+	Match(arg interface{}) bool
+	String() string
+}
+
+type matcher struct {
+	match       func(arg interface{}) bool
+	description string
+}
+
+func (m matcher) Match(arg interface{}) bool {
+	return m.match(arg)
+}
+
+func (m matcher) String() string {
+	return m.description
+}
+
+// Eq matches arguments that are deeply equal to value.
+func Eq(value interface{}) Matcher {
+	return matcher{
+		match:       func(arg interface{}) bool { return reflect.DeepEqual(value, arg) },
+		description: fmt.Sprintf("%#v", value),
+	}
+}
+
+// Any matches any argument.
+func Any() Matcher {
+	return matcher{
+		match:       func(interface{}) bool { return true },
+		description: "<any>",
+	}
+}
+
+// AnyOfType matches arguments with the same type as sample.
+func AnyOfType(sample interface{}) Matcher {
+	sampleType := reflect.TypeOf(sample)
+	return matcher{
+		match:       func(arg interface{}) bool { return reflect.TypeOf(arg) == sampleType },
+		description: fmt.Sprintf("<any %v>", sampleType),
+	}
+}
+
+// MatchedBy matches arguments for which fn returns true. Description is used when reporting the expectation.
+func MatchedBy(description string, fn func(arg interface{}) bool) Matcher {
+	return matcher{
+		match:       fn,
+		description: "<" + description + ">",
+	}
+}
@@ -0,0 +1,199 @@
+// Package mock provide an expectation-based call recorder for building test doubles.
+//
+// A test double embeds Mock and forwards each method call to Called:
+//
+//	type storeMock struct{ mock.Mock }
+//
+//	func (m *storeMock) Get(key string) (string, error) {
+//		r := m.Called("Get", key)
+//		return r.String(0), r.Error(1)
+//	}
+//
+// Tests then declare expectations with On and check them with should.MeetExpectations.
+package mock
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// unlimited marks an expectation without an upper bound on its calls.
+const unlimited int = -1
+
+// Mock records calls and matches them against expectations.
+// It is safe for concurrent use.
+type Mock struct {
+	mu           sync.Mutex
+	expectations []*Expectation
+	calls        []Call
+	unexpected   []Call
+}
+
+// Call is a method call recorded by Mock.
+type Call struct {
+	Method    string
+	Arguments []interface{}
+}
+
+// Expectation describes calls a Mock is expected to receive and what they return.
+type Expectation struct {
+	method   string
+	matchers []Matcher
+	results  Results
+	min      int
+	max      int
+	calls    int
+}
+
+// On declares that method is expected to be called with arguments matching args.
+// Args can be values, which are compared with Eq, or Matchers.
+// By default the expectation must be met at least once.
+func (m *Mock) On(method string, args ...interface{}) *Expectation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	e := &Expectation{method: method, min: 1, max: unlimited}
+	for _, arg := range args {
+		matcher, ok := arg.(Matcher)
+		if !ok {
+			matcher = Eq(arg)
+		}
+		e.matchers = append(e.matchers, matcher)
+	}
+
+	m.expectations = append(m.expectations, e)
+	return e
+}
+
+// Return sets the results returned by calls matching the expectation.
+func (e *Expectation) Return(results ...interface{}) *Expectation {
+	e.results = results
+	return e
+}
+
+// Times sets the exact number of calls expected.
+func (e *Expectation) Times(n int) *Expectation {
+	e.min, e.max = n, n
+	return e
+}
+
+// Once expects exactly one call.
+func (e *Expectation) Once() *Expectation {
+	return e.Times(1)
+}
+
+// Maybe allows any number of calls, including none.
+func (e *Expectation) Maybe() *Expectation {
+	e.min, e.max = 0, unlimited
+	return e
+}
+
+// String returns the expected call in the form Method(matchers...).
+func (e *Expectation) String() string {
+	args := make([]string, len(e.matchers))
+	for i, matcher := range e.matchers {
+		args[i] = matcher.String()
+	}
+
+	return e.method + "(" + strings.Join(args, ", ") + ")"
+}
+
+func (e *Expectation) matches(method string, args []interface{}) bool {
+	if e.method != method || len(e.matchers) != len(args) {
+		return false
+	}
+
+	for i, matcher := range e.matchers {
+		if !matcher.Match(args[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (e *Expectation) exhausted() bool {
+	return e.max != unlimited && e.calls >= e.max
+}
+
+// Called records a call to method with args and returns the results of the first matching expectation
+// that has calls left. Calls without such an expectation are recorded as unexpected and return no results.
+func (m *Mock) Called(method string, args ...interface{}) Results {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	call := Call{Method: method, Arguments: args}
+	m.calls = append(m.calls, call)
+
+	for _, e := range m.expectations {
+		if !e.exhausted() && e.matches(method, args) {
+			e.calls++
+			return e.results
+		}
+	}
+
+	m.unexpected = append(m.unexpected, call)
+	return nil
+}
+
+// Calls returns every call recorded so far, in order.
+func (m *Mock) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return append([]Call(nil), m.calls...)
+}
+
+// MissingCalls describes the expectations that were called fewer times than required.
+func (m *Mock) MissingCalls() []string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var missing []string
+	for _, e := range m.expectations {
+		if e.calls < e.min {
+			missing = append(missing, fmt.Sprintf("%s: expected %s, called %s", e, times(e.min), times(e.calls)))
+		}
+	}
+
+	return missing
+}
+
+// UnexpectedCalls describes the calls that did not match any expectation with calls left.
+func (m *Mock) UnexpectedCalls() []string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var unexpected []string
+	for _, call := range m.unexpected {
+		reason := "no matching expectation"
+		for _, e := range m.expectations {
+			if e.matches(call.Method, call.Arguments) {
+				reason = fmt.Sprintf("exceeds %s, expected %s", e, times(e.max))
+				break
+			}
+		}
+		unexpected = append(unexpected, call.String()+": "+reason)
+	}
+
+	return unexpected
+}
+
+// String returns the call in the form Method(arguments...).
+func (c Call) String() string {
+	args := make([]string, len(c.Arguments))
+	for i, arg := range c.Arguments {
+		args[i] = fmt.Sprintf("%#v", arg)
+	}
+
+	return c.Method + "(" + strings.Join(args, ", ") + ")"
+}
+
+func times(n int) string {
+	if n == 1 {
+		return "1 time"
+	}
+
+	return fmt.Sprintf("%d times", n)
+}
@@ -0,0 +1,57 @@
+package mock
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestCalled(t *testing.T) {
+	assertThat := func(assumption string, m *Mock, method string, args []interface{}, expected Results) {
+		results := m.Called(method, args...)
+
+		if !reflect.DeepEqual(expected, results) {
+			t.Errorf("%s: wanted '%#v' got '%#v'", assumption, expected, results)
+		}
+	}
+
+	errNotFound := errors.New("not found")
+	m := &Mock{}
+	m.On("Get", "a").Return("1", nil).Once()
+	m.On("Get", MatchedBy("short key", func(arg interface{}) bool { return len(arg.(string)) < 3 })).Return("", errNotFound)
+
+	assertThat("should return results of the first matching expectation", m, "Get", []interface{}{"a"}, Results{"1", nil})
+	assertThat("should move on once an expectation is exhausted", m, "Get", []interface{}{"a"}, Results{"", errNotFound})
+	assertThat("should return no results for unexpected calls", m, "Get", []interface{}{"long"}, nil)
+	assertThat("should return no results for different arity", m, "Get", []interface{}{"a", "b"}, nil)
+
+	expectedCalls := []Call{
+		{Method: "Get", Arguments: []interface{}{"a"}},
+		{Method: "Get", Arguments: []interface{}{"a"}},
+		{Method: "Get", Arguments: []interface{}{"long"}},
+		{Method: "Get", Arguments: []interface{}{"a", "b"}},
+	}
+	if calls := m.Calls(); !reflect.DeepEqual(expectedCalls, calls) {
+		t.Errorf("wanted '%#v' got '%#v'", expectedCalls, calls)
+	}
+
+	expectedUnexpected := []string{`Get("long"): no matching expectation`, `Get("a", "b"): no matching expectation`}
+	if unexpected := m.UnexpectedCalls(); !reflect.DeepEqual(expectedUnexpected, unexpected) {
+		t.Errorf("wanted '%#v' got '%#v'", expectedUnexpected, unexpected)
+	}
+	if missing := m.MissingCalls(); missing != nil {
+		t.Errorf("wanted '%#v' got '%#v'", nil, missing)
+	}
+}
+
+func TestResults(t *testing.T) {
+	err := errors.New("failed")
+	r := Results{"a", 1, true, err, nil}
+
+	if r.String(0) != "a" || r.Int(1) != 1 || !r.Bool(2) || r.Error(3) != err {
+		t.Errorf("wanted typed results got '%#v'", r)
+	}
+	if r.Error(4) != nil || r.Get(5) != nil || r.String(1) != "" {
+		t.Error("wanted zero values for nil, missing and mistyped results")
+	}
+}
@@ -0,0 +1,38 @@
+package mock
+
+// Results holds the values returned by Mock.Called.
+// Its accessors return the zero value when a result is missing or nil.
+type Results []interface{}
+
+// Get returns the result at index i.
+func (r Results) Get(i int) interface{} {
+	if i < 0 || i >= len(r) {
+		return nil
+	}
+
+	return r[i]
+}
+
+// Error returns the result at index i as an error.
+func (r Results) Error(i int) error {
+	err, _ := r.Get(i).(error)
+	return err
+}
+
+// String returns the result at index i as a string.
+func (r Results) String(i int) string {
+	value, _ := r.Get(i).(string)
+	return value
+}
+
+// Int returns the result at index i as an int.
+func (r Results) Int(i int) int {
+	value, _ := r.Get(i).(int)
+	return value
+}
+
+// Bool returns the result at index i as a bool.
+func (r Results) Bool(i int) bool {
+	value, _ := r.Get(i).(bool)
+	return value
+}
@@ -0,0 +1,116 @@
+package should
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+	"sync"
+)
+
+// newPipe creates the pipes CaptureOutput reads from.
+var newPipe = os.Pipe
+
+// CaptureOutput runs fn and returns everything it wrote to os.Stdout and os.Stderr.
+// Both are read while fn runs, so output larger than the pipe buffers does not block fn.
+// They are global, so tests capturing output must not run in parallel with tests that print.
+func CaptureOutput(fn func()) (stdout, stderr string) {
+	outReader, outWriter, err := newPipe()
+	if err != nil {
+		panic(fmt.Sprintf("should: capturing output: %v", err))
+	}
+	errReader, errWriter, err := newPipe()
+	if err != nil {
+		outReader.Close()
+		outWriter.Close()
+		panic(fmt.Sprintf("should: capturing output: %v", err))
+	}
+
+	var out, errOut bytes.Buffer
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go drain(&wg, &out, outReader)
+	go drain(&wg, &errOut, errReader)
+
+	originalOut, originalErr := os.Stdout, os.Stderr
+	os.Stdout, os.Stderr = outWriter, errWriter
+	defer func() {
+		os.Stdout, os.Stderr = originalOut, originalErr
+		outWriter.Close()
+		errWriter.Close()
+
+		wg.Wait()
+		outReader.Close()
+		errReader.Close()
+		stdout, stderr = out.String(), errOut.String()
+	}()
+
+	fn()
+	return
+}
+
+// PrintExactly fails the test if fn does not print exactly expected to os.Stdout.
+func (s *Should) PrintExactly(fn func(), expected string, assumption ...string) {
+	defer s.track("PrintExactly", assumption)()
+
+	if stdout, _ := CaptureOutput(fn); stdout != expected {
+		s.t.Helper()
+		s.t.Log(fmt.Sprintf(differencesLogFormat, describe(s.assumed(assumption), "PrintExactly", "%[1]s prints %[2]s"), "PrintExactly",
+			"stdout differs", escape(expected), escape(stdout), strings.Join(diffLines(expected, stdout), differencesIndent)))
+		s.t.Fail()
+	}
+}
+
+// PrintContaining fails the test if what fn prints to os.Stdout does not contain substr.
+func (s *Should) PrintContaining(fn func(), substr string, assumption ...string) {
+	defer s.track("PrintContaining", assumption)()
+
+	if stdout, _ := CaptureOutput(fn); !strings.Contains(stdout, substr) {
+		s.t.Helper()
+		s.t.Log(fmt.Sprintf(valuesLogFormat, describe(s.assumed(assumption), "PrintContaining", "%[1]s prints %[2]s"), "PrintContaining",
+			fmt.Sprintf("containing %s", escape(substr)), escape(stdout)))
+		s.t.Fail()
+	}
+}
+
+// PrintMatchingGolden fails the test if what fn prints to os.Stdout differs from the golden file at path,
+// which is written from the output instead when the SHOULD_UPDATE environment variable is set.
+func (s *Should) PrintMatchingGolden(fn func(), path string, assumption ...string) {
+	defer s.track("PrintMatchingGolden", assumption)()
+
+	stdout, _ := CaptureOutput(fn)
+
+	if os.Getenv(UpdateEnv) != "" {
+		if err := writeFile(path, stdout); err != nil {
+			s.t.Helper()
+			s.t.Log(fmt.Sprintf(pathReasonLogFormat, describe(s.assumed(assumption), "PrintMatchingGolden", "%[1]s prints %[2]s"), "PrintMatchingGolden",
+				err.Error(), path, "golden file written", nil))
+			s.t.Fail()
+		}
+		return
+	}
+
+	golden, err := ioutil.ReadFile(path) // #nosec G304
+	if err != nil {
+		s.t.Helper()
+		s.t.Log(fmt.Sprintf(pathReasonLogFormat, describe(s.assumed(assumption), "PrintMatchingGolden", "%[1]s prints %[2]s"), "PrintMatchingGolden",
+			fmt.Sprintf("%v (set %s=1 to record it)", err, UpdateEnv), path, "golden file", escape(stdout)))
+		s.t.Fail()
+		return
+	}
+
+	if expected := string(golden); stdout != expected {
+		s.t.Helper()
+		s.t.Log(fmt.Sprintf(pathContentLogFormat, describe(s.assumed(assumption), "PrintMatchingGolden", "%[1]s prints %[2]s"), "PrintMatchingGolden",
+			path, escape(expected), escape(stdout), strings.Join(diffLines(expected, stdout), differencesIndent)))
+		s.t.Fail()
+	}
+}
+
+// drain copies everything read from r into buf, until r is closed.
+func drain(wg *sync.WaitGroup, buf *bytes.Buffer, r io.Reader) {
+	defer wg.Done()
+	_, _ = io.Copy(buf, r)
+}
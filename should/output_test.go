@@ -0,0 +1,160 @@
+package should
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestCaptureOutput(t *testing.T) {
+	t.Run("captures stdout and stderr", func(t *testing.T) {
+		stdout, stderr := CaptureOutput(func() {
+			fmt.Println("out")
+			fmt.Fprintln(os.Stderr, "err")
+		})
+
+		if stdout != "out\n" || stderr != "err\n" {
+			t.Errorf("wanted 'out\\n' and 'err\\n' got %q and %q", stdout, stderr)
+		}
+	})
+
+	t.Run("captures output larger than the pipe buffers", func(t *testing.T) {
+		large := strings.Repeat("0123456789abcdef", 1<<16)
+		stdout, stderr := CaptureOutput(func() {
+			fmt.Print(large)
+			fmt.Fprint(os.Stderr, large)
+		})
+
+		if stdout != large || stderr != large {
+			t.Errorf("wanted %d bytes on each got %d and %d", len(large), len(stdout), len(stderr))
+		}
+	})
+
+	t.Run("restores stdout and stderr", func(t *testing.T) {
+		stdout, stderr := os.Stdout, os.Stderr
+		func() {
+			defer func() { _ = recover() }()
+			CaptureOutput(func() { panic("boom") })
+		}()
+
+		if os.Stdout != stdout || os.Stderr != stderr {
+			t.Error("wanted os.Stdout and os.Stderr to be restored")
+		}
+	})
+	t.Run("closes the first pipe when the second cannot be created", func(t *testing.T) {
+		defer func(original func() (*os.File, *os.File, error)) { newPipe = original }(newPipe)
+		var created []*os.File
+		newPipe = func() (*os.File, *os.File, error) {
+			if len(created) > 0 {
+				return nil, nil, errors.New("too many open files")
+			}
+			r, w, err := os.Pipe()
+			created = append(created, r, w)
+			return r, w, err
+		}
+
+		var recovered interface{}
+		func() {
+			defer func() { recovered = recover() }()
+			CaptureOutput(func() {})
+		}()
+
+		if recovered != "should: capturing output: too many open files" {
+			t.Errorf("wanted a panic for the second pipe got %v", recovered)
+		}
+		for _, f := range created {
+			if err := f.Close(); !errors.Is(err, os.ErrClosed) {
+				t.Errorf("wanted %s to be closed got %v", f.Name(), err)
+			}
+		}
+	})
+}
+
+func TestPrint(t *testing.T) {
+	greet := func() { fmt.Print("hello\nworld\n") }
+	golden := filepath.Join(t.TempDir(), "greet.golden")
+	if err := ioutil.WriteFile(golden, []byte("hello\nthere\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("scenarios that must fail tests", func(t *testing.T) {
+		assertThat := func(assumption string, check func(*Should), expectedLogMessage string) {
+			stub := testingStub{}
+			should := New(&stub)
+
+			check(should)
+
+			if !stub.hasFailed {
+				t.Error("test was expected to fail but did not")
+			}
+			if !stub.WasHelperCalled() {
+				t.Error("Helper() call was expected but did not happen")
+			}
+			if expectedLogMessage != stub.logMessage {
+				t.Errorf("wanted '%s' got '%s'", expectedLogMessage, stub.logMessage)
+			}
+		}
+
+		assertThat("should fail when the output differs",
+			func(s *Should) { s.PrintExactly(greet, "hello\nthere\n", "greets") },
+			"\nassumption: [ greets ]\n    should: PrintExactly \n    reason: stdout differs\n  expected: hello\\nthere\\n\n    actual: hello\\nworld\\n\n"+
+				"   differs:   hello\n            - there\n            + world")
+		assertThat("should fail when the output does not contain the text",
+			func(s *Should) { s.PrintContaining(greet, "bye", "says goodbye") },
+			"\nassumption: [ says goodbye ]\n    should: PrintContaining \n  expected: containing bye\n    actual: hello\\nworld\\n")
+		assertThat("should fail when the output differs from the golden file",
+			func(s *Should) { s.PrintMatchingGolden(greet, golden, "greets") },
+			"\nassumption: [ greets ]\n    should: PrintMatchingGolden \n      path: "+golden+"\n  expected: hello\\nthere\\n\n    actual: hello\\nworld\\n\n"+
+				"   differs:   hello\n            - there\n            + world")
+		assertThat("should fail when the golden file is missing",
+			func(s *Should) { s.PrintMatchingGolden(greet, "missing.golden", "greets") },
+			"\nassumption: [ greets ]\n    should: PrintMatchingGolden \n    reason: open missing.golden: no such file or directory (set SHOULD_UPDATE=1 to record it)\n"+
+				"      path: missing.golden\n  expected: golden file\n    actual: hello\\nworld\\n")
+	})
+
+	t.Run("scenarios that must not fail tests", func(t *testing.T) {
+		assertThat := func(assumption string, check func(*Should)) {
+			stub := testingStub{}
+			should := New(&stub)
+
+			check(should)
+
+			if stub.hasFailed {
+				t.Error("test was expected to not fail but it did")
+			}
+			if stub.WasHelperCalled() {
+				t.Error("Helper() call was not expected but it happened")
+			}
+		}
+
+		assertThat("should pass for the exact output",
+			func(s *Should) { s.PrintExactly(greet, "hello\nworld\n") })
+		assertThat("should pass when the output contains the text",
+			func(s *Should) { s.PrintContaining(greet, "o\nw") })
+		assertThat("should ignore stderr",
+			func(s *Should) { s.PrintExactly(func() { fmt.Fprint(os.Stderr, "warning") }, "") })
+	})
+
+	t.Run("updates the golden file", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "testdata", "greet.golden")
+		t.Setenv(UpdateEnv, "1")
+		stub := testingStub{}
+
+		New(&stub).PrintMatchingGolden(greet, path)
+
+		content, err := ioutil.ReadFile(path)
+		if stub.hasFailed || err != nil || string(content) != "hello\nworld\n" {
+			t.Errorf("wanted golden file 'hello\\nworld\\n' got %q (%v)", content, err)
+		}
+
+		t.Setenv(UpdateEnv, "")
+		New(&stub).PrintMatchingGolden(greet, path)
+		if stub.hasFailed {
+			t.Error("wanted the output to match the updated golden file")
+		}
+	})
+}
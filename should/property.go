@@ -0,0 +1,541 @@
+package should
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	propertyLogFormat string = "\nassumption: [ %s ]\n    should: %s \n    reason: %s\n  expected: %v\n    actual: %v\n     input: %s\n  original: %s\n      seed: %d"
+
+	defaultIterations  int = 100
+	defaultMaxSize     int = 50
+	maxShrinkAttempts  int = 10000
+	propertyAssumption     = "property holds for all inputs"
+)
+
+// unicodeRunes are mixed into generated strings, so properties also meet multi-byte and control characters.
+var unicodeRunes = []rune{'é', 'ß', 'λ', '世', '🙂', '\n', '\t', 0}
+
+// PropertyOption configures ForAll.
+type PropertyOption func(*propertyConfig)
+
+type propertyConfig struct {
+	iterations int
+	maxSize    int
+	seed       int64
+}
+
+// WithIterations sets how many inputs are generated. Defaults to 100.
+func WithIterations(n int) PropertyOption {
+	return func(c *propertyConfig) {
+		c.iterations = n
+	}
+}
+
+// WithMaxSize bounds the length of generated strings, slices and maps, and the magnitude of numbers.
+// Inputs grow towards it as iterations progress. Defaults to 50.
+func WithMaxSize(n int) PropertyOption {
+	return func(c *propertyConfig) {
+		c.maxSize = n
+	}
+}
+
+// WithSeed sets the seed inputs are generated from, to reproduce a failure reported by ForAll.
+// Defaults to the current time.
+func WithSeed(seed int64) PropertyOption {
+	return func(c *propertyConfig) {
+		c.seed = seed
+	}
+}
+
+// ForAll fails the test if property, a function returning bool, does not hold for randomly generated inputs.
+// Inputs can be booleans, numbers, strings, slices, arrays, maps, structs and pointers to them; unexported
+// struct fields are left empty. A failing input is shrunk to a minimal counterexample before it is reported,
+// together with the seed that reproduces it. A panic in property counts as a failure.
+func ForAll(t TestingT, property interface{}, opts ...PropertyOption) {
+	t = reporting(t)
+	defer asserting(t, "ForAll", []string{propertyAssumption})()
+	config := propertyConfig{iterations: defaultIterations, maxSize: defaultMaxSize, seed: time.Now().UnixNano()}
+	for _, opt := range opts {
+		opt(&config)
+	}
+
+	fn := reflect.ValueOf(property)
+	if fn.Kind() != reflect.Func || fn.Type().NumOut() != 1 || fn.Type().Out(0).Kind() != reflect.Bool {
+		t.Helper()
+		t.Log(fmt.Sprintf(reasonLogFormat, propertyAssumption, "ForAll",
+			"property must be a function returning bool", "func(...) bool", fmt.Sprintf("%T", property)))
+		t.Fail()
+		return
+	}
+
+	rng := rand.New(rand.NewSource(config.seed)) // #nosec G404
+	for i := 0; i < config.iterations; i++ {
+		size := config.maxSize * i / config.iterations
+
+		args := make([]reflect.Value, fn.Type().NumIn())
+		for j := range args {
+			arg, err := generateValue(rng, fn.Type().In(j), size)
+			if err != nil {
+				t.Helper()
+				t.Log(fmt.Sprintf(reasonLogFormat, propertyAssumption, "ForAll", err.Error(), "generated inputs", "none"))
+				t.Fail()
+				return
+			}
+			args[j] = arg
+		}
+
+		if holds, _ := checkProperty(fn, args); holds {
+			continue
+		}
+
+		shrunk, shrinks := shrinkInputs(fn, args)
+		_, panicked := checkProperty(fn, shrunk)
+
+		reason := fmt.Sprintf("falsified after %d of %d iterations and %d shrinks", i+1, config.iterations, shrinks)
+		actual := "false"
+		if panicked != nil {
+			actual = fmt.Sprintf("panic: %v", panicked)
+		}
+
+		t.Helper()
+		t.Log(fmt.Sprintf(propertyLogFormat, propertyAssumption, "ForAll", reason,
+			"true", actual, formatInputs(shrunk), formatInputs(args), config.seed))
+		t.Fail()
+		return
+	}
+}
+
+// checkProperty calls fn with args, returning whether it held and what it panicked with, if anything.
+func checkProperty(fn reflect.Value, args []reflect.Value) (holds bool, panicked interface{}) {
+	defer func() {
+		if r := recover(); r != nil {
+			holds, panicked = false, r
+		}
+	}()
+
+	if fn.Type().IsVariadic() {
+		return fn.CallSlice(args)[0].Bool(), nil
+	}
+	return fn.Call(args)[0].Bool(), nil
+}
+
+// shrinkInputs repeatedly replaces an argument with a simpler candidate that still falsifies fn,
+// until no candidate does. It returns the simplest inputs found and how many replacements were made.
+func shrinkInputs(fn reflect.Value, args []reflect.Value) ([]reflect.Value, int) {
+	shrinks, attempts := 0, 0
+
+	for attempts < maxShrinkAttempts {
+		improved := false
+		for i := 0; i < len(args) && !improved; i++ {
+			for _, candidate := range shrinkValue(args[i]) {
+				attempts++
+				try := append([]reflect.Value(nil), args...)
+				try[i] = candidate
+
+				if holds, _ := checkProperty(fn, try); !holds {
+					args, improved = try, true
+					shrinks++
+					break
+				}
+				if attempts >= maxShrinkAttempts {
+					break
+				}
+			}
+		}
+
+		if !improved {
+			break
+		}
+	}
+
+	return args, shrinks
+}
+
+// generateValue returns a random value of type t, whose magnitude is bounded by size.
+// Composite elements and pointees get a share of size, see elementSize, and pointers are
+// nil once it reaches zero, so values of recursive types, such as trees, stop growing.
+func generateValue(rng *rand.Rand, t reflect.Type, size int) (reflect.Value, error) {
+	v := reflect.New(t).Elem()
+
+	switch t.Kind() {
+	case reflect.Bool:
+		v.SetBool(rng.Intn(2) == 0)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		v.SetInt(generateInt(rng, t.Bits(), size))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		v.SetUint(generateUint(rng, t.Bits(), size))
+	case reflect.Float32, reflect.Float64:
+		v.SetFloat(rng.NormFloat64() * float64(size))
+	case reflect.Complex64, reflect.Complex128:
+		v.SetComplex(complex(rng.NormFloat64()*float64(size), rng.NormFloat64()*float64(size)))
+	case reflect.String:
+		v.SetString(generateString(rng, size))
+	case reflect.Slice:
+		n := rng.Intn(size + 1)
+		v.Set(reflect.MakeSlice(t, n, n))
+		return v, generateElements(rng, v, elementSize(t.Elem(), size, n))
+	case reflect.Array:
+		return v, generateElements(rng, v, elementSize(t.Elem(), size, t.Len()))
+	case reflect.Map:
+		v.Set(reflect.MakeMap(t))
+		n := rng.Intn(size + 1)
+		keySize, valueSize := elementSize(t.Key(), size, n), elementSize(t.Elem(), size, n)
+		for ; n > 0; n-- {
+			key, err := generateValue(rng, t.Key(), keySize)
+			if err != nil {
+				return v, err
+			}
+			value, err := generateValue(rng, t.Elem(), valueSize)
+			if err != nil {
+				return v, err
+			}
+			v.SetMapIndex(key, value)
+		}
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if !v.Field(i).CanSet() {
+				continue
+			}
+			field, err := generateValue(rng, t.Field(i).Type, size)
+			if err != nil {
+				return v, err
+			}
+			v.Field(i).Set(field)
+		}
+	case reflect.Ptr:
+		if size == 0 || rng.Intn(4) == 0 {
+			return v, nil
+		}
+		elem, err := generateValue(rng, t.Elem(), size/2)
+		if err != nil {
+			return v, err
+		}
+		v.Set(reflect.New(t.Elem()))
+		v.Elem().Set(elem)
+	default:
+		return v, fmt.Errorf("cannot generate values of type %s", t)
+	}
+
+	return v, nil
+}
+
+// elementSize returns the size of each of n elements of type t. Scalar elements keep size,
+// while composite ones share it, so nested values stay within size overall.
+func elementSize(t reflect.Type, size, n int) int {
+	switch t.Kind() {
+	case reflect.Slice, reflect.Array, reflect.Map, reflect.Struct, reflect.Ptr:
+		return size / (n + 1)
+	}
+	return size
+}
+
+func generateElements(rng *rand.Rand, v reflect.Value, size int) error {
+	for i := 0; i < v.Len(); i++ {
+		elem, err := generateValue(rng, v.Type().Elem(), size)
+		if err != nil {
+			return err
+		}
+		v.Index(i).Set(elem)
+	}
+	return nil
+}
+
+// generateInt mostly returns numbers within [-size, size], and occasionally the edges of the type.
+func generateInt(rng *rand.Rand, bits, size int) int64 {
+	if rng.Intn(10) == 0 {
+		limit := int64(1)<<(bits-1) - 1
+		edges := []int64{0, 1, -1, limit, -limit - 1}
+		return edges[rng.Intn(len(edges))]
+	}
+
+	return rng.Int63n(int64(2*size+1)) - int64(size)
+}
+
+// generateUint mostly returns numbers within [0, size], and occasionally the edges of the type.
+func generateUint(rng *rand.Rand, bits, size int) uint64 {
+	if rng.Intn(10) == 0 {
+		edges := []uint64{0, 1, math.MaxUint64 >> (64 - bits)}
+		return edges[rng.Intn(len(edges))]
+	}
+
+	return uint64(rng.Int63n(int64(size + 1)))
+}
+
+func generateString(rng *rand.Rand, size int) string {
+	runes := make([]rune, rng.Intn(size+1))
+	for i := range runes {
+		if rng.Intn(10) == 0 {
+			runes[i] = unicodeRunes[rng.Intn(len(unicodeRunes))]
+		} else {
+			runes[i] = rune(' ' + rng.Intn('~'-' '+1))
+		}
+	}
+	return string(runes)
+}
+
+// shrinkValue returns simpler variations of v, simplest first. It never modifies v.
+func shrinkValue(v reflect.Value) []reflect.Value {
+	var candidates []reflect.Value
+	add := func(set func(reflect.Value)) {
+		c := reflect.New(v.Type()).Elem()
+		c.Set(v)
+		set(c)
+		candidates = append(candidates, c)
+	}
+
+	switch v.Kind() {
+	case reflect.Bool:
+		if v.Bool() {
+			add(func(c reflect.Value) { c.SetBool(false) })
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		for _, n := range shrinkInt(v.Int()) {
+			n := n
+			add(func(c reflect.Value) { c.SetInt(n) })
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		if n := v.Uint(); n > 0 {
+			add(func(c reflect.Value) { c.SetUint(0) })
+			if n/2 > 0 {
+				add(func(c reflect.Value) { c.SetUint(n / 2) })
+			}
+			if n-1 > n/2 {
+				add(func(c reflect.Value) { c.SetUint(n - 1) })
+			}
+		}
+	case reflect.Float32, reflect.Float64:
+		if f := v.Float(); f != 0 {
+			add(func(c reflect.Value) { c.SetFloat(0) })
+			if t := math.Trunc(f); t != f && t != 0 {
+				add(func(c reflect.Value) { c.SetFloat(t) })
+			}
+			if math.Abs(f) >= 2 {
+				add(func(c reflect.Value) { c.SetFloat(math.Trunc(f / 2)) })
+			}
+		}
+	case reflect.Complex64, reflect.Complex128:
+		if v.Complex() != 0 {
+			add(func(c reflect.Value) { c.SetComplex(0) })
+		}
+	case reflect.String:
+		runes := []rune(v.String())
+		for _, s := range shrinkRunes(runes) {
+			s := s
+			add(func(c reflect.Value) { c.SetString(s) })
+		}
+	case reflect.Slice:
+		candidates = shrinkSlice(v)
+	case reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			for _, elem := range shrinkValue(v.Index(i)) {
+				i, elem := i, elem
+				add(func(c reflect.Value) { c.Index(i).Set(elem) })
+			}
+		}
+	case reflect.Map:
+		candidates = shrinkMap(v)
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if !v.Field(i).CanSet() {
+				continue
+			}
+			for _, field := range shrinkValue(v.Field(i)) {
+				i, field := i, field
+				add(func(c reflect.Value) { c.Field(i).Set(field) })
+			}
+		}
+	case reflect.Ptr:
+		if v.IsNil() {
+			break
+		}
+		candidates = append(candidates, reflect.Zero(v.Type()))
+		for _, elem := range shrinkValue(v.Elem()) {
+			p := reflect.New(v.Type().Elem())
+			p.Elem().Set(elem)
+			candidates = append(candidates, p)
+		}
+	}
+
+	return candidates
+}
+
+// shrinkInt moves n towards zero, preferring positive numbers.
+func shrinkInt(n int64) []int64 {
+	if n == 0 {
+		return nil
+	}
+
+	candidates := []int64{0}
+	if n < 0 && -n > 0 {
+		candidates = append(candidates, -n)
+	}
+	if n/2 != 0 {
+		candidates = append(candidates, n/2)
+	}
+
+	step := n - 1
+	if n < 0 {
+		step = n + 1
+	}
+	if step != 0 && step != n/2 {
+		candidates = append(candidates, step)
+	}
+	return candidates
+}
+
+// shrinkRunes returns the empty string, both halves and every single-rune removal of runes.
+func shrinkRunes(runes []rune) []string {
+	n := len(runes)
+	if n == 0 {
+		return nil
+	}
+
+	candidates := []string{""}
+	if n > 1 {
+		candidates = append(candidates, string(runes[:n/2]), string(runes[n/2:]))
+	}
+	for i := 0; i < n && n > 1; i++ {
+		candidates = append(candidates, string(runes[:i])+string(runes[i+1:]))
+	}
+	for i, r := range runes {
+		if r != 'a' {
+			simpler := append([]rune(nil), runes...)
+			simpler[i] = 'a'
+			candidates = append(candidates, string(simpler))
+		}
+	}
+	return candidates
+}
+
+// shrinkSlice returns an empty slice, both halves, every single-element removal
+// and every single-element simplification of v.
+func shrinkSlice(v reflect.Value) []reflect.Value {
+	n := v.Len()
+	if n == 0 {
+		return nil
+	}
+
+	concat := func(parts ...reflect.Value) reflect.Value {
+		s := reflect.MakeSlice(v.Type(), 0, n)
+		for _, part := range parts {
+			s = reflect.AppendSlice(s, part)
+		}
+		return s
+	}
+
+	candidates := []reflect.Value{reflect.MakeSlice(v.Type(), 0, 0)}
+	if n > 1 {
+		candidates = append(candidates, concat(v.Slice(0, n/2)), concat(v.Slice(n/2, n)))
+	}
+	for i := 0; i < n && n > 1; i++ {
+		candidates = append(candidates, concat(v.Slice(0, i), v.Slice(i+1, n)))
+	}
+	for i := 0; i < n; i++ {
+		for _, elem := range shrinkValue(v.Index(i)) {
+			s := concat(v)
+			s.Index(i).Set(elem)
+			candidates = append(candidates, s)
+		}
+	}
+	return candidates
+}
+
+// shrinkMap returns an empty map, every single-entry removal and every single-value simplification of v.
+func shrinkMap(v reflect.Value) []reflect.Value {
+	if v.Len() == 0 {
+		return nil
+	}
+
+	keys := v.MapKeys()
+	sort.Slice(keys, func(i, j int) bool { return formatValue(keys[i]) < formatValue(keys[j]) })
+
+	clone := func(skip int) reflect.Value {
+		m := reflect.MakeMapWithSize(v.Type(), len(keys))
+		for i, key := range keys {
+			if i != skip {
+				m.SetMapIndex(key, v.MapIndex(key))
+			}
+		}
+		return m
+	}
+
+	candidates := []reflect.Value{reflect.MakeMap(v.Type())}
+	for i := range keys {
+		if len(keys) > 1 {
+			candidates = append(candidates, clone(i))
+		}
+	}
+	for i, key := range keys {
+		for _, elem := range shrinkValue(v.MapIndex(key)) {
+			m := clone(-1)
+			m.SetMapIndex(keys[i], elem)
+			candidates = append(candidates, m)
+		}
+	}
+	return candidates
+}
+
+func formatInputs(args []reflect.Value) string {
+	values := make([]string, len(args))
+	for i, arg := range args {
+		values[i] = formatValue(arg)
+	}
+	return strings.Join(values, ", ")
+}
+
+// formatValue prints v as Go syntax, following pointers instead of printing addresses.
+func formatValue(v reflect.Value) string {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			return fmt.Sprintf("(%s)(nil)", v.Type())
+		}
+		return "&" + formatValue(v.Elem())
+	case reflect.Slice, reflect.Array:
+		if v.Kind() == reflect.Slice && v.IsNil() {
+			return fmt.Sprintf("%s(nil)", v.Type())
+		}
+		if v.Type() == reflect.TypeOf([]byte(nil)) {
+			return fmt.Sprintf("[]byte(%q)", v.Bytes())
+		}
+		elems := make([]string, v.Len())
+		for i := range elems {
+			elems[i] = formatValue(v.Index(i))
+		}
+		return fmt.Sprintf("%s{%s}", v.Type(), strings.Join(elems, ", "))
+	case reflect.Map:
+		if v.IsNil() {
+			return fmt.Sprintf("%s(nil)", v.Type())
+		}
+		entries := make([]string, 0, v.Len())
+		for _, key := range v.MapKeys() {
+			entries = append(entries, formatValue(key)+": "+formatValue(v.MapIndex(key)))
+		}
+		sort.Strings(entries)
+		return fmt.Sprintf("%s{%s}", v.Type(), strings.Join(entries, ", "))
+	case reflect.Struct:
+		fields := make([]string, 0, v.NumField())
+		for i := 0; i < v.NumField(); i++ {
+			if v.Field(i).CanInterface() {
+				fields = append(fields, v.Type().Field(i).Name+": "+formatValue(v.Field(i)))
+			}
+		}
+		return fmt.Sprintf("%s{%s}", v.Type(), strings.Join(fields, ", "))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return fmt.Sprintf("%d", v.Uint())
+	case reflect.Invalid:
+		return "<nil>"
+	}
+
+	if !v.CanInterface() {
+		return v.String()
+	}
+	return fmt.Sprintf("%#v", v.Interface())
+}
@@ -0,0 +1,123 @@
+package should
+
+import (
+	"sort"
+	"strings"
+	"testing"
+)
+
+type order struct {
+	ID    int
+	Items []string
+	Note  *string
+	total int
+}
+
+type tree struct {
+	Value    int
+	Children []tree
+	Parent   *tree
+	Index    map[string]tree
+}
+
+func TestForAll(t *testing.T) {
+	t.Run("scenarios that must fail tests", func(t *testing.T) {
+		assertThat := func(assumption string, property interface{}, expectedLogMessage string) {
+			stub := testingStub{}
+
+			ForAll(&stub, property, WithSeed(1))
+
+			if !stub.hasFailed {
+				t.Error("test was expected to fail but did not")
+			}
+			if !stub.WasHelperCalled() {
+				t.Error("Helper() call was expected but did not happen")
+			}
+			if expectedLogMessage != stub.logMessage {
+				t.Errorf("wanted '%s' got '%s'", expectedLogMessage, stub.logMessage)
+			}
+		}
+
+		assertThat("should shrink ints towards zero",
+			func(a, b int) bool { return a+b < 20 },
+			"\nassumption: [ property holds for all inputs ]\n    should: ForAll \n    reason: falsified after 54 of 100 iterations and 7 shrinks"+
+				"\n  expected: true\n    actual: false\n     input: 7, 13\n  original: 26, 13\n      seed: 1")
+		assertThat("should shrink strings",
+			func(s string) bool { return !strings.Contains(s, "b") },
+			"\nassumption: [ property holds for all inputs ]\n    should: ForAll \n    reason: falsified after 11 of 100 iterations and 2 shrinks"+
+				"\n  expected: true\n    actual: false\n     input: \"b\"\n  original: \"|fb\"\n      seed: 1")
+		assertThat("should shrink slices",
+			func(xs []int) bool { return len(xs) < 3 },
+			"\nassumption: [ property holds for all inputs ]\n    should: ForAll \n    reason: falsified after 11 of 100 iterations and 2 shrinks"+
+				"\n  expected: true\n    actual: false\n     input: []int{0, 0, 0}\n  original: []int{-3, -3, 0}\n      seed: 1")
+		assertThat("should shrink maps",
+			func(m map[string]int) bool { return len(m) < 2 },
+			"\nassumption: [ property holds for all inputs ]\n    should: ForAll \n    reason: falsified after 6 of 100 iterations and 2 shrinks"+
+				"\n  expected: true\n    actual: false\n     input: map[string]int{\"\": 0, \"G\": 0}\n  original: map[string]int{\"\": -2, \"G\": 1}\n      seed: 1")
+		assertThat("should shrink structs and pointers",
+			func(o order) bool { return o.Note == nil || len(o.Items) < 2 },
+			"\nassumption: [ property holds for all inputs ]\n    should: ForAll \n    reason: falsified after 5 of 100 iterations and 3 shrinks"+
+				"\n  expected: true\n    actual: false\n     input: should.order{ID: 0, Items: []string{\"\", \"\"}, Note: &\"\"}"+
+				"\n  original: should.order{ID: 1, Items: []string{\"pA\", \"E\"}, Note: &\"\"}\n      seed: 1")
+		assertThat("should report panics",
+			func(p *int) bool { return *p >= 0 },
+			"\nassumption: [ property holds for all inputs ]\n    should: ForAll \n    reason: falsified after 1 of 100 iterations and 0 shrinks"+
+				"\n  expected: true\n    actual: panic: runtime error: invalid memory address or nil pointer dereference"+
+				"\n     input: (*int)(nil)\n  original: (*int)(nil)\n      seed: 1")
+		assertThat("should shrink unsigned ints and floats",
+			func(u uint8, f float64) bool { return u < 200 && f < 10 },
+			"\nassumption: [ property holds for all inputs ]\n    should: ForAll \n    reason: falsified after 4 of 100 iterations and 56 shrinks"+
+				"\n  expected: true\n    actual: false\n     input: 200, 0\n  original: 255, -0.7477263446701804\n      seed: 1")
+		assertThat("should fail for types that cannot be generated",
+			func(c chan int) bool { return true },
+			"\nassumption: [ property holds for all inputs ]\n    should: ForAll \n    reason: cannot generate values of type chan int"+
+				"\n  expected: generated inputs\n    actual: none")
+		assertThat("should fail for properties that are not predicates",
+			func(a int) int { return a },
+			"\nassumption: [ property holds for all inputs ]\n    should: ForAll \n    reason: property must be a function returning bool"+
+				"\n  expected: func(...) bool\n    actual: func(int) int")
+	})
+
+	t.Run("scenarios that must not fail tests", func(t *testing.T) {
+		assertThat := func(assumption string, property interface{}, opts ...PropertyOption) {
+			stub := testingStub{}
+
+			ForAll(&stub, property, opts...)
+
+			if stub.hasFailed {
+				t.Error("test was expected to not fail but it did")
+			}
+			if stub.WasHelperCalled() {
+				t.Error("Helper() call was not expected")
+			}
+			if stub.logMessage != "" {
+				t.Errorf("wanted '%s' got '%s'", "", stub.logMessage)
+			}
+		}
+
+		assertThat("should not fail for properties that hold", func(xs []int) bool {
+			sort.Ints(xs)
+			return sort.IntsAreSorted(xs)
+		})
+		assertThat("should not fail for commutative operations", func(a, b int16) bool { return a+b == b+a })
+		assertThat("should not fail for variadic properties", func(sep string, parts ...string) bool {
+			return len(parts) == 0 || len(strings.Join(parts, sep)) >= len(sep)*(len(parts)-1)
+		}, WithIterations(20), WithMaxSize(5))
+		assertThat("should not fail within the maximum size", func(s string, m map[int]bool) bool {
+			return len([]rune(s)) <= 3 && len(m) <= 3
+		}, WithMaxSize(3))
+		assertThat("should not fail for recursive types", func(t tree) bool { return true })
+	})
+
+	t.Run("reproduces inputs from the seed", func(t *testing.T) {
+		run := func() string {
+			stub := testingStub{}
+			ForAll(&stub, func(o order, xs [2]float32) bool { return o.ID < 5 }, WithSeed(42))
+			return stub.logMessage
+		}
+
+		if first, second := run(), run(); first == "" || first != second {
+			t.Errorf("wanted the same failure twice got '%s' and '%s'", first, second)
+		}
+	})
+}
@@ -0,0 +1,329 @@
+package should
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// ReportEnv is the environment variable holding the path RunWithReport writes its report to.
+// Paths ending in .xml get JUnit XML, and paths ending in .md get Markdown.
+const ReportEnv string = "SHOULD_REPORT"
+
+var (
+	// activeReport collects failures while RunWithReport runs the tests.
+	activeReport *failureReport
+	reportMu     sync.Mutex
+
+	// reportOutput is where RunWithReport prints its summary.
+	reportOutput io.Writer = os.Stdout
+)
+
+// failure is a single assertion that failed.
+type failure struct {
+	test       string
+	assertion  string
+	assumption string
+	message    string
+}
+
+// assertion is the assertion method being checked, and its assumption.
+type assertion struct {
+	method     string
+	assumption string
+}
+
+// assertingT is implemented by wrappers that record which assertion their failures belong to.
+type assertingT interface {
+	// assert sets the assertion being checked, returning the one it replaces.
+	assert(a assertion) assertion
+}
+
+// asserting tells the wrappers of t that its failures belong to method, until the returned
+// function is called. Without an assumption, the source of the call describes the assertion.
+func asserting(t TestingT, method string, assumption []string) func() {
+	var current *assertion
+	var restore []func()
+	for ; t != nil; t = unwrapT(t) {
+		w, ok := t.(assertingT)
+		if !ok {
+			continue
+		}
+
+		if current == nil {
+			current = &assertion{method: method, assumption: strings.TrimSpace(strings.Join(assumption, " "))}
+			if current.assumption == "" {
+				current.assumption = describeCall(method)
+			}
+		}
+		previous := w.assert(*current)
+		restore = append(restore, func() { w.assert(previous) })
+	}
+
+	return func() {
+		for _, fn := range restore {
+			fn()
+		}
+	}
+}
+
+type failureReport struct {
+	mu       sync.Mutex
+	failures []failure
+}
+
+func (r *failureReport) add(f failure) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.failures = append(r.failures, f)
+}
+
+func (r *failureReport) snapshot() []failure {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]failure(nil), r.failures...)
+}
+
+// RunWithReport runs the tests and prints a summary of every assertion that failed through Should,
+// grouped by test and by assertion. It is meant to be called from TestMain:
+//
+//	func TestMain(m *testing.M) {
+//		os.Exit(should.RunWithReport(m))
+//	}
+//
+// When the SHOULD_REPORT environment variable holds a path, the report is also written
+// there as JUnit XML or Markdown, depending on its extension.
+func RunWithReport(m interface{ Run() int }) int {
+	report := &failureReport{}
+	reportMu.Lock()
+	activeReport = report
+	reportMu.Unlock()
+
+	defer func() {
+		reportMu.Lock()
+		activeReport = nil
+		reportMu.Unlock()
+	}()
+
+	code := m.Run()
+	failures := report.snapshot()
+	fmt.Fprint(reportOutput, summarise(failures))
+
+	if path := os.Getenv(ReportEnv); path != "" {
+		if err := writeReport(path, failures); err != nil {
+			fmt.Fprintf(reportOutput, "should: writing report: %v\n", err)
+			if code == 0 {
+				code = 1
+			}
+		}
+	}
+
+	return code
+}
+
+// reportingT records every failure into report before passing it on.
+type reportingT struct {
+	TestingT
+	report    *failureReport
+	mu        sync.Mutex
+	pending   []string
+	assertion assertion
+}
+
+// reporting wraps t so its failures are recorded, while RunWithReport runs the tests.
+func reporting(t TestingT) TestingT {
+	reportMu.Lock()
+	defer reportMu.Unlock()
+
+	if activeReport == nil {
+		return t
+	}
+	return &reportingT{TestingT: t, report: activeReport}
+}
+
+func (t *reportingT) Log(args ...interface{}) {
+	t.TestingT.Helper()
+
+	t.mu.Lock()
+	t.pending = append(t.pending, fmt.Sprint(args...))
+	t.mu.Unlock()
+
+	t.TestingT.Log(args...)
+}
+
+func (t *reportingT) Fail() {
+	t.TestingT.Helper()
+
+	t.mu.Lock()
+	f := newFailure(t.assertion, strings.Join(t.pending, "\n"))
+	t.pending = nil
+	t.mu.Unlock()
+
+	f.test = testName(t.TestingT)
+	t.report.add(f)
+	t.TestingT.Fail()
+}
+
+func (t *reportingT) assert(a assertion) assertion {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	previous := t.assertion
+	t.assertion = a
+	return previous
+}
+
+func (t *reportingT) discardPending() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.pending = nil
+}
+
+func (t *reportingT) unwrap() TestingT {
+	return t.TestingT
+}
+
+// newFailure returns a failure of a with message. Failures outside of any assertion
+// are reported as "(unknown)".
+func newFailure(a assertion, message string) failure {
+	f := failure{assertion: a.method, assumption: a.assumption, message: message}
+	if f.assertion == "" {
+		f.assertion = "(unknown)"
+	}
+	return f
+}
+
+// countBy counts failures by key, returning the keys in order of first appearance.
+func countBy(failures []failure, key func(failure) string) ([]string, map[string]int) {
+	var keys []string
+	counts := make(map[string]int)
+	for _, f := range failures {
+		k := key(f)
+		if counts[k] == 0 {
+			keys = append(keys, k)
+		}
+		counts[k]++
+	}
+	return keys, counts
+}
+
+func summarise(failures []failure) string {
+	if len(failures) == 0 {
+		return "should: no failed assertions\n"
+	}
+
+	tests, _ := countBy(failures, func(f failure) string { return f.test })
+	var b strings.Builder
+	fmt.Fprintf(&b, "should: %s in %s\n", plural(len(failures), "failed assertion"), plural(len(tests), "test"))
+
+	width := 0
+	for _, f := range failures {
+		if len(f.assertion) > width {
+			width = len(f.assertion)
+		}
+	}
+
+	for _, test := range tests {
+		fmt.Fprintf(&b, "\n  %s\n", test)
+		writeCounts(&b, failuresOf(failures, test), width)
+	}
+
+	b.WriteString("\n  by assertion\n")
+	writeCounts(&b, failures, width)
+	return b.String()
+}
+
+func writeCounts(w io.Writer, failures []failure, width int) {
+	assertions, counts := countBy(failures, func(f failure) string { return f.assertion })
+	sort.Strings(assertions)
+	for _, assertion := range assertions {
+		fmt.Fprintf(w, "    %-*s %d\n", width, assertion, counts[assertion])
+	}
+}
+
+func failuresOf(failures []failure, test string) []failure {
+	var matched []failure
+	for _, f := range failures {
+		if f.test == test {
+			matched = append(matched, f)
+		}
+	}
+	return matched
+}
+
+func plural(n int, noun string) string {
+	if n == 1 {
+		return "1 " + noun
+	}
+	return fmt.Sprintf("%d %ss", n, noun)
+}
+
+func writeReport(path string, failures []failure) error {
+	var content string
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".xml":
+		data, err := junitReport(failures)
+		if err != nil {
+			return err
+		}
+		content = string(data)
+	case ".md", ".markdown":
+		content = markdownReport(failures)
+	default:
+		return fmt.Errorf("%s: unsupported report format, use .xml or .md", path)
+	}
+
+	return writeFile(path, content)
+}
+
+// junitReport renders one test suite per test, with one failed test case per assertion.
+func junitReport(failures []failure) ([]byte, error) {
+	tests, _ := countBy(failures, func(f failure) string { return f.test })
+	suites := make([]junitTestSuite, 0, len(tests))
+
+	for i, test := range tests {
+		suite := newJUnitSuite(i, test, junitClock())
+		for _, f := range failuresOf(failures, test) {
+			suite.Cases = append(suite.Cases, junitTestCase{
+				Name:      f.assertion + ": " + f.assumption,
+				ClassName: test,
+				Time:      junitSeconds(0),
+				Failure:   &junitFailure{Message: f.assumption, Type: f.assertion, Text: f.message},
+			})
+		}
+		suite.Tests, suite.Failures = len(suite.Cases), len(suite.Cases)
+		suites = append(suites, suite)
+	}
+
+	return marshalJUnit(suites)
+}
+
+var markdownEscaper = strings.NewReplacer("|", "\\|", "\n", "<br>")
+
+// markdownReport renders the failures as a table, followed by the count per assertion.
+func markdownReport(failures []failure) string {
+	var b strings.Builder
+	b.WriteString("# Assertion failures\n\n")
+	if len(failures) == 0 {
+		b.WriteString("No failed assertions.\n")
+		return b.String()
+	}
+
+	tests, _ := countBy(failures, func(f failure) string { return f.test })
+	fmt.Fprintf(&b, "%s in %s.\n\n", plural(len(failures), "failed assertion"), plural(len(tests), "test"))
+	b.WriteString("| Test | Assertion | Assumption |\n| --- | --- | --- |\n")
+	for _, f := range failures {
+		fmt.Fprintf(&b, "| %s | %s | %s |\n", markdownEscaper.Replace(f.test), f.assertion, markdownEscaper.Replace(f.assumption))
+	}
+
+	b.WriteString("\n## By assertion\n\n| Assertion | Failures |\n| --- | --- |\n")
+	assertions, counts := countBy(failures, func(f failure) string { return f.assertion })
+	sort.Strings(assertions)
+	for _, assertion := range assertions {
+		fmt.Fprintf(&b, "| %s | %d |\n", assertion, counts[assertion])
+	}
+	return b.String()
+}
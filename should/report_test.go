@@ -0,0 +1,171 @@
+package should
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+)
+
+type namedStub struct {
+	testingStub
+	name string
+}
+
+func (t *namedStub) Name() string {
+	return t.name
+}
+
+type mainStub func() int
+
+func (m mainStub) Run() int {
+	return m()
+}
+
+// runFailingSuite runs assertions that fail in two tests through RunWithReport.
+func runFailingSuite() (int, string) {
+	var out bytes.Buffer
+	defer func(original io.Writer) { reportOutput = original }(reportOutput)
+	reportOutput = &out
+
+	code := RunWithReport(mainStub(func() int {
+		sum := New(&namedStub{name: "TestSum"})
+		sum.BeEqual(42, 41, "sum | equals 42")
+		sum.BeTrue(false, "sum is positive")
+		sum.BeEqual(1, 1, "passes")
+		sum.BeEqual(2, 3, "sum equals 2")
+
+		NewFuzz(&namedStub{name: "FuzzSplit"}, "a,b").BeFalse(true, "split is empty")
+		return 1
+	}))
+
+	return code, out.String()
+}
+
+func TestRunWithReport(t *testing.T) {
+	t.Run("prints a summary grouped by test and assertion", func(t *testing.T) {
+		code, out := runFailingSuite()
+
+		expected := "should: 4 failed assertions in 2 tests\n" +
+			"\n  TestSum\n    BeEqual 2\n    BeTrue  1\n" +
+			"\n  FuzzSplit\n    BeFalse 1\n" +
+			"\n  by assertion\n    BeEqual 2\n    BeFalse 1\n    BeTrue  1\n"
+		if code != 1 {
+			t.Errorf("wanted exit code 1 got %d", code)
+		}
+		if out != expected {
+			t.Errorf("wanted '%s' got '%s'", expected, out)
+		}
+	})
+
+	t.Run("writes a JUnit report", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "report.xml")
+		t.Setenv(ReportEnv, path)
+		fixJUnitEnvironment(t)
+
+		runFailingSuite()
+
+		content, err := ioutil.ReadFile(path)
+		expected := `<?xml version="1.0" encoding="UTF-8"?>
+<testsuites>
+  <testsuite package="" id="0" name="TestSum" timestamp="2024-01-02T03:04:05" hostname="ci-runner" tests="3" failures="3" errors="0" time="0.000">
+    <properties></properties>
+    <testcase name="BeEqual: sum | equals 42" classname="TestSum" time="0.000">
+      <failure message="sum | equals 42" type="BeEqual">&#xA; assumption: [ sum | equals 42 ]&#xA;     should: BeEqual &#xA;   expected: 42&#xA;     actual: 41&#xA;type expect: int&#xA;type actual: int</failure>
+    </testcase>
+    <testcase name="BeTrue: sum is positive" classname="TestSum" time="0.000">
+      <failure message="sum is positive" type="BeTrue">&#xA;assumption: [ sum is positive ]&#xA;    should: BeTrue &#xA;  expected: true&#xA;    actual: false</failure>
+    </testcase>
+    <testcase name="BeEqual: sum equals 2" classname="TestSum" time="0.000">
+      <failure message="sum equals 2" type="BeEqual">&#xA; assumption: [ sum equals 2 ]&#xA;     should: BeEqual &#xA;   expected: 2&#xA;     actual: 3&#xA;type expect: int&#xA;type actual: int</failure>
+    </testcase>
+    <system-out></system-out>
+    <system-err></system-err>
+  </testsuite>
+  <testsuite package="" id="1" name="FuzzSplit" timestamp="2024-01-02T03:04:05" hostname="ci-runner" tests="1" failures="1" errors="0" time="0.000">
+    <properties></properties>
+    <testcase name="BeFalse: split is empty" classname="FuzzSplit" time="0.000">
+      <failure message="split is empty" type="BeFalse">&#xA;assumption: [ split is empty ]&#xA;    should: BeFalse &#xA;  expected: false&#xA;    actual: true&#xA;fuzz input: &#34;a,b&#34;</failure>
+    </testcase>
+    <system-out></system-out>
+    <system-err></system-err>
+  </testsuite>
+</testsuites>
+`
+		if err != nil || string(content) != expected {
+			t.Errorf("wanted '%s' got '%s' (%v)", expected, content, err)
+		}
+	})
+
+	t.Run("writes a Markdown report", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "report.md")
+		t.Setenv(ReportEnv, path)
+
+		runFailingSuite()
+
+		content, err := ioutil.ReadFile(path)
+		expected := "# Assertion failures\n\n4 failed assertions in 2 tests.\n\n" +
+			"| Test | Assertion | Assumption |\n| --- | --- | --- |\n" +
+			"| TestSum | BeEqual | sum \\| equals 42 |\n" +
+			"| TestSum | BeTrue | sum is positive |\n" +
+			"| TestSum | BeEqual | sum equals 2 |\n" +
+			"| FuzzSplit | BeFalse | split is empty |\n" +
+			"\n## By assertion\n\n| Assertion | Failures |\n| --- | --- |\n" +
+			"| BeEqual | 2 |\n| BeFalse | 1 |\n| BeTrue | 1 |\n"
+		if err != nil || string(content) != expected {
+			t.Errorf("wanted '%s' got '%s' (%v)", expected, content, err)
+		}
+	})
+
+	t.Run("fails for unsupported report formats", func(t *testing.T) {
+		var out bytes.Buffer
+		defer func(original io.Writer) { reportOutput = original }(reportOutput)
+		reportOutput = &out
+		t.Setenv(ReportEnv, "report.txt")
+
+		code := RunWithReport(mainStub(func() int { return 0 }))
+
+		expected := "should: no failed assertions\nshould: writing report: report.txt: unsupported report format, use .xml or .md\n"
+		if code != 1 {
+			t.Errorf("wanted exit code 1 got %d", code)
+		}
+		if out.String() != expected {
+			t.Errorf("wanted '%s' got '%s'", expected, out.String())
+		}
+	})
+
+	t.Run("records the assertion and assumption of each failure", func(t *testing.T) {
+		report := &failureReport{}
+		should := New(&reportingT{TestingT: &namedStub{name: "TestList"}, report: report})
+
+		should.BeTrue(false, "list is [ 1 ] long")
+		should.Retry(2, 0, func(r *Should) { r.BeEqual(1, 2, "one is [ 2 ]") }, "retries")
+		should.BeEqual(1, 2)
+
+		expected := []failure{
+			{test: "TestList", assertion: "BeTrue", assumption: "list is [ 1 ] long"},
+			{test: "TestList", assertion: "BeEqual", assumption: "one is [ 2 ]"},
+			{test: "TestList", assertion: "BeEqual", assumption: "BeEqual(1, 2)"},
+		}
+		failures := report.snapshot()
+		if len(failures) != len(expected) {
+			t.Fatalf("wanted %d failures got %d", len(expected), len(failures))
+		}
+		for i, f := range failures {
+			f.message = ""
+			if f != expected[i] {
+				t.Errorf("wanted %+v got %+v", expected[i], f)
+			}
+		}
+	})
+
+	t.Run("does not record outside of RunWithReport", func(t *testing.T) {
+		stub := testingStub{}
+		should := New(&stub)
+
+		if should.t != &stub {
+			t.Error("runner was not expected to be wrapped")
+		}
+	})
+}
@@ -0,0 +1,141 @@
+package should
+
+import (
+	"fmt"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+)
+
+const retryLogFormat string = "\nassumption: [ %s ]\n    should: %s \n    reason: %s\n   earlier: %s"
+
+// Retry runs block up to attempts times, until an attempt passes, waiting backoff before the first
+// retry and doubling the wait after each one. Every attempt gets a fresh Should that records its
+// failures, and only the failures of the last attempt are passed on to the test. The failures of
+// earlier attempts are then logged in a summary, so flaky assertions remain visible. Retrying stops
+// early once the context given to WithContext is done.
+//
+//	should.Retry(3, 100*time.Millisecond, func(r *should.Should) {
+//		r.NotError(emulator.Ping(), "emulator is up")
+//	})
+func (s *Should) Retry(attempts int, backoff time.Duration, block func(r *Should), assumption ...string) {
+	defer s.track("Retry", assumption)()
+
+	if attempts < 1 {
+		attempts = 1
+	}
+
+	var earlier []string
+	var failures []failure
+	attempt := 1
+	for ; ; attempt++ {
+		failures = runAttempt(s, block)
+		if len(failures) == 0 || attempt == attempts || !s.wait(backoff) {
+			break
+		}
+
+		for _, f := range failures {
+			earlier = append(earlier, fmt.Sprintf("attempt %d: %s: %s", attempt, f.assertion, f.assumption))
+		}
+		backoff *= 2
+	}
+
+	s.t.Helper()
+	for _, f := range failures {
+		done := asserting(s.t, f.assertion, []string{f.assumption})
+		if f.message != "" {
+			s.t.Log(f.message)
+		}
+		s.t.Fail()
+		done()
+	}
+
+	if len(earlier) > 0 {
+		reason := fmt.Sprintf("passed on attempt %d of %d", attempt, attempts)
+		if len(failures) > 0 {
+			reason = fmt.Sprintf("failed %d of %d attempts", attempt, attempts)
+		}
+		s.note(fmt.Sprintf(retryLogFormat, describe(s.assumed(assumption), "Retry", "block passes within %[1]s attempts"), "Retry",
+			reason, strings.Join(earlier, differencesIndent)))
+	}
+}
+
+// wait sleeps for d, returning false if the context of s is done first.
+func (s *Should) wait(d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return true
+	case <-s.context().Done():
+		return false
+	}
+}
+
+// runAttempt runs block in its own goroutine, so FailNow can stop it, and returns its failures.
+func runAttempt(s *Should, block func(r *Should)) []failure {
+	t := &recordingT{TestingT: s.t}
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+		block(&Should{t: t, ctx: s.ctx, because: s.because})
+	}()
+	<-done
+
+	return t.recorded()
+}
+
+// recordingT records failures instead of passing them on.
+type recordingT struct {
+	TestingT
+	mu        sync.Mutex
+	pending   []string
+	failures  []failure
+	assertion assertion
+}
+
+func (t *recordingT) Log(args ...interface{}) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.pending = append(t.pending, fmt.Sprint(args...))
+}
+
+func (t *recordingT) Fail() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.failures = append(t.failures, newFailure(t.assertion, strings.Join(t.pending, "\n")))
+	t.pending = nil
+}
+
+// FailNow records a failure and stops the attempt.
+func (t *recordingT) FailNow() {
+	t.Fail()
+	runtime.Goexit()
+}
+
+func (t *recordingT) discardPending() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.pending = nil
+}
+
+func (t *recordingT) unwrap() TestingT {
+	return t.TestingT
+}
+
+func (t *recordingT) assert(a assertion) assertion {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	previous := t.assertion
+	t.assertion = a
+	return previous
+}
+
+func (t *recordingT) recorded() []failure {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return append([]failure(nil), t.failures...)
+}
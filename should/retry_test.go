@@ -0,0 +1,153 @@
+package should
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"strings"
+	"testing"
+	"time"
+)
+
+// logsStub keeps every message logged, unlike testingStub which only keeps the last one.
+type logsStub struct {
+	testingStub
+	logs     []string
+	failures int
+}
+
+func (t *logsStub) Log(args ...interface{}) {
+	t.testingStub.Log(args...)
+	t.logs = append(t.logs, t.logMessage)
+}
+
+func (t *logsStub) Fail() {
+	t.testingStub.Fail()
+	t.failures++
+}
+
+func TestRetry(t *testing.T) {
+	failing := func(times int) (func(r *Should), *int) {
+		calls := 0
+		return func(r *Should) {
+			calls++
+			r.BeTrue(calls > times, "call passes")
+			r.BeEqual(1, 1, "one is one")
+		}, &calls
+	}
+
+	t.Run("passes on the first attempt without logging", func(t *testing.T) {
+		stub := &logsStub{}
+		block, calls := failing(0)
+
+		New(stub).Retry(3, time.Millisecond, block, "block passes")
+
+		if stub.hasFailed || len(stub.logs) > 0 || *calls != 1 {
+			t.Errorf("wanted a single silent attempt got %d attempts, failed %v and logs %q", *calls, stub.hasFailed, stub.logs)
+		}
+	})
+
+	t.Run("logs earlier failures when a retry passes", func(t *testing.T) {
+		stub := &logsStub{}
+		block, calls := failing(2)
+
+		New(stub).Retry(3, time.Millisecond, block, "block passes")
+
+		expected := "\nassumption: [ block passes ]\n    should: Retry \n    reason: passed on attempt 3 of 3\n" +
+			"   earlier: attempt 1: BeTrue: call passes\n            attempt 2: BeTrue: call passes"
+		if stub.hasFailed || *calls != 3 {
+			t.Errorf("wanted 3 attempts without failing got %d attempts, failed %v", *calls, stub.hasFailed)
+		}
+		if len(stub.logs) != 1 || stub.logs[0] != expected {
+			t.Errorf("wanted '%s' got %q", expected, stub.logs)
+		}
+	})
+
+	t.Run("summarises assumptions containing brackets", func(t *testing.T) {
+		stub := &logsStub{}
+		calls := 0
+
+		New(stub).Retry(2, 0, func(r *Should) {
+			calls++
+			r.BeTrue(calls > 1, "list is [ 1 ] long")
+		}, "block passes")
+
+		expected := "\nassumption: [ block passes ]\n    should: Retry \n    reason: passed on attempt 2 of 2\n" +
+			"   earlier: attempt 1: BeTrue: list is [ 1 ] long"
+		if len(stub.logs) != 1 || stub.logs[0] != expected {
+			t.Errorf("wanted '%s' got %q", expected, stub.logs)
+		}
+	})
+
+	t.Run("passes on the failures of the last attempt", func(t *testing.T) {
+		stub := &logsStub{}
+		block, calls := failing(5)
+
+		New(stub).Retry(2, 0, block, "block passes")
+
+		expected := []string{
+			"\nassumption: [ call passes ]\n    should: BeTrue \n  expected: true\n    actual: false",
+			"\nassumption: [ block passes ]\n    should: Retry \n    reason: failed 2 of 2 attempts\n   earlier: attempt 1: BeTrue: call passes",
+		}
+		if stub.failures != 1 || !stub.WasHelperCalled() || *calls != 2 {
+			t.Errorf("wanted 1 failure from 2 attempts got %d failures from %d attempts", stub.failures, *calls)
+		}
+		if len(stub.logs) != 2 || stub.logs[0] != expected[0] || stub.logs[1] != expected[1] {
+			t.Errorf("wanted %q got %q", expected, stub.logs)
+		}
+	})
+
+	t.Run("stops an attempt on FailNow", func(t *testing.T) {
+		stub := &logsStub{}
+		reached := false
+
+		New(stub).Retry(1, 0, func(r *Should) {
+			r.FailNow()
+			reached = true
+		})
+
+		if reached || stub.failures != 1 {
+			t.Errorf("wanted the attempt to stop with 1 failure got %d failures, reached %v", stub.failures, reached)
+		}
+	})
+
+	t.Run("stops retrying once the context is done", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		stub := &logsStub{}
+		block, calls := failing(5)
+
+		New(stub).WithContext(ctx).Retry(5, time.Hour, block)
+
+		if *calls != 1 || stub.failures != 1 {
+			t.Errorf("wanted 1 failed attempt got %d attempts and %d failures", *calls, stub.failures)
+		}
+	})
+
+	t.Run("keeps the summary out of later failures", func(t *testing.T) {
+		var out bytes.Buffer
+		defer func(original io.Writer) { reportOutput = original }(reportOutput)
+		reportOutput = &out
+		runner := NewStandalone(nil)
+
+		RunWithReport(mainStub(func() int {
+			for _, s := range []*Should{New(&namedStub{name: "TestFlaky"}), New(runner)} {
+				block, _ := failing(1)
+				s.Retry(2, 0, block, "flaky")
+				s.BeEqual(1, 2, "real failure")
+			}
+			return 1
+		}))
+
+		expected := "should: 2 failed assertions in 2 tests\n" +
+			"\n  TestFlaky\n    BeEqual 1\n" +
+			"\n  (unknown test)\n    BeEqual 1\n" +
+			"\n  by assertion\n    BeEqual 2\n"
+		if out.String() != expected {
+			t.Errorf("wanted '%s' got '%s'", expected, out.String())
+		}
+		if failures := runner.Failures(); len(failures) != 1 || strings.Contains(failures[0], "Retry") {
+			t.Errorf("wanted a single BeEqual failure got %q", failures)
+		}
+	})
+}
@@ -2,6 +2,7 @@
 package should
 
 import (
+	"context"
 	"fmt"
 	"reflect"
 	"strings"
@@ -14,65 +15,129 @@ const (
 	missingItemsLogFormat        string = "\nassumption: [ %s ]\n    should: %s \n    reason: %s\n  expected: %v\n    actual: %v\n   missing: %v"
 	lengthMismatchLogFormat      string = "\nassumption: [ %s ]\n    should: %s \n    reason: %s\n  expected: %v\n    actual: %v\nlength exp: %v\nlength act: %v"
 	reasonLogFormat              string = "\nassumption: [ %s ]\n    should: %s \n    reason: %s\n  expected: %v\n    actual: %v"
+
+	sameItemsTemplate string = "%[2]s has the same items as %[1]s"
 )
 
 // Should define easy to use methods for testing go applications.
 type Should struct {
-	t testingT
+	t       TestingT
+	junit   *JUnitReporter
+	ctx     context.Context
+	because []string
 }
 
-type testingT interface {
-	Helper()
-	Log(args ...interface{})
-	Fail()
+// New initialises a new Should instance.
+// See TestingT for the runners it works with.
+func New(t TestingT, opts ...Option) *Should {
+	return newShould(t, nil, opts)
 }
 
-// New initialises a new Should instance.
-func New(t testingT) *Should {
-	return &Should{t}
+// newShould initialises a new Should instance, letting decorate wrap its runner
+// after the wrappers that record failures, so they see decorated messages.
+func newShould(t TestingT, decorate func(TestingT) TestingT, opts []Option) *Should {
+	s := &Should{t: reporting(t)}
+
+	reportMu.Lock()
+	s.junit = defaultJUnit
+	reportMu.Unlock()
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	if s.junit != nil {
+		s.t = &junitT{TestingT: s.t}
+	}
+	if decorate != nil {
+		s.t = decorate(s.t)
+	}
+	return s
+}
+
+// Assert runs a custom assertion named method, such as those of packages built on top of this one.
+// The assertion fails the test with the message returned by failure, unless it is empty, and is
+// recorded by RunWithReport and JUnit reporters like the assertions of Should. Failure is given
+// the assumption, the one set through Because, or method when neither was given:
+//
+//	s.Assert("BeEven", func(assumption string) string {
+//		if n%2 == 0 {
+//			return ""
+//		}
+//		return should.Failure(assumption, "BeEven", "expected", "even", "actual", n)
+//	}, assumption...)
+func (s *Should) Assert(method string, failure func(assumption string) string, assumption ...string) {
+	defer s.track(method, assumption)()
+
+	if message := failure(describe(s.assumed(assumption), method, method)); message != "" {
+		s.t.Helper()
+		s.t.Log(message)
+		s.t.Fail()
+	}
+}
+
+// Failure formats the message of a failed assertion the way the assertions of Should do, for
+// custom assertions reported through Assert. Details are pairs of label and value, each rendered
+// on its own line, e.g. "expected", 200, "actual", 404.
+func Failure(assumption, method string, details ...interface{}) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "\nassumption: [ %s ]\n    should: %s ", assumption, method)
+	for i := 0; i+1 < len(details); i += 2 {
+		fmt.Fprintf(&b, "\n%10v: %v", details[i], details[i+1])
+	}
+	return b.String()
 }
 
 // BeNil fails the test if value is not nil.
-func (s *Should) BeNil(value interface{}, assumption string) {
+func (s *Should) BeNil(value interface{}, assumption ...string) {
+	defer s.track("BeNil", assumption)()
+
 	if !isNil(value) {
 		s.t.Helper()
-		s.t.Log(fmt.Sprintf(singleValueWithTypeLogFormat, assumption, "BeNil", nil, value, value))
+		s.t.Log(fmt.Sprintf(singleValueWithTypeLogFormat, describe(s.assumed(assumption), "BeNil", "%[1]s is nil"), "BeNil", nil, value, value))
 		s.t.Fail()
 	}
 }
 
 // BeNotNil fails the test if value is nil.
-func (s *Should) BeNotNil(value interface{}, assumption string) {
+func (s *Should) BeNotNil(value interface{}, assumption ...string) {
+	defer s.track("BeNotNil", assumption)()
+
 	if isNil(value) {
 		s.t.Helper()
-		s.t.Log(fmt.Sprintf(valuesLogFormat, assumption, "BeNotNil", "!= nil", value))
+		s.t.Log(fmt.Sprintf(valuesLogFormat, describe(s.assumed(assumption), "BeNotNil", "%[1]s is not nil"), "BeNotNil", "!= nil", value))
 		s.t.Fail()
 	}
 }
 
 // Error fails the test if err is nil.
-func (s *Should) Error(err error, assumption string) {
+func (s *Should) Error(err error, assumption ...string) {
+	defer s.track("Error", assumption)()
+
 	if isNil(err) {
 		s.t.Helper()
-		s.t.Log(fmt.Sprintf(valuesLogFormat, assumption, "Error", "!= nil", err))
+		s.t.Log(fmt.Sprintf(valuesLogFormat, describe(s.assumed(assumption), "Error", "%[1]s is an error"), "Error", "!= nil", err))
 		s.t.Fail()
 	}
 }
 
 // NotError fails the test if err is not nil.
-func (s *Should) NotError(err error, assumption string) {
+func (s *Should) NotError(err error, assumption ...string) {
+	defer s.track("NotError", assumption)()
+
 	if !isNil(err) {
 		s.t.Helper()
-		s.t.Log(fmt.Sprintf(valuesLogFormat, assumption, "NotError", "nil", err))
+		s.t.Log(fmt.Sprintf(valuesLogFormat, describe(s.assumed(assumption), "NotError", "%[1]s is not an error"), "NotError", "nil", err))
 		s.t.Fail()
 	}
 }
 
 // BeEqual compares the values of both expected and actual and fails the test if they differ.
-func (s *Should) BeEqual(expected, actual interface{}, assumption string) {
+func (s *Should) BeEqual(expected, actual interface{}, assumption ...string) {
+	defer s.track("BeEqual", assumption)()
+
 	if !reflect.DeepEqual(expected, actual) {
 		s.t.Helper()
-		s.t.Log(fmt.Sprintf(valuesWithTypeLogFormat, assumption, "BeEqual",
+		s.t.Log(fmt.Sprintf(valuesWithTypeLogFormat, describe(s.assumed(assumption), "BeEqual", "%[2]s equals %[1]s"), "BeEqual",
 			escape(expected), escape(actual),
 			expected, actual))
 		s.t.Fail()
@@ -80,52 +145,62 @@ func (s *Should) BeEqual(expected, actual interface{}, assumption string) {
 }
 
 // BeNotEqual compares the values of both expected and actual and fails the test if they are equal.
-func (s *Should) BeNotEqual(expected, actual interface{}, assumption string) {
+func (s *Should) BeNotEqual(expected, actual interface{}, assumption ...string) {
+	defer s.track("BeNotEqual", assumption)()
+
 	if reflect.DeepEqual(expected, actual) {
 		s.t.Helper()
-		s.t.Log(fmt.Sprintf(valuesLogFormat, assumption, "BeNotEqual",
+		s.t.Log(fmt.Sprintf(valuesLogFormat, describe(s.assumed(assumption), "BeNotEqual", "%[2]s does not equal %[1]s"), "BeNotEqual",
 			escape(expected), escape(actual)))
 		s.t.Fail()
 	}
 }
 
 // BeTrue fails the test if value is false.
-func (s *Should) BeTrue(value bool, assumption string) {
+func (s *Should) BeTrue(value bool, assumption ...string) {
+	defer s.track("BeTrue", assumption)()
+
 	if !value {
 		s.t.Helper()
-		s.t.Log(fmt.Sprintf(valuesLogFormat, assumption, "BeTrue", true, value))
+		s.t.Log(fmt.Sprintf(valuesLogFormat, describe(s.assumed(assumption), "BeTrue", "%[1]s is true"), "BeTrue", true, value))
 		s.t.Fail()
 	}
 }
 
 // BeFalse fails the test if value is true.
-func (s *Should) BeFalse(value bool, assumption string) {
+func (s *Should) BeFalse(value bool, assumption ...string) {
+	defer s.track("BeFalse", assumption)()
+
 	if value {
 		s.t.Helper()
-		s.t.Log(fmt.Sprintf(valuesLogFormat, assumption, "BeFalse", false, value))
+		s.t.Log(fmt.Sprintf(valuesLogFormat, describe(s.assumed(assumption), "BeFalse", "%[1]s is false"), "BeFalse", false, value))
 		s.t.Fail()
 	}
 }
 
 // HaveSameType compares the types of both expected and actual and fails the test if they differ.
-func (s *Should) HaveSameType(expected, actual interface{}, assumption string) {
+func (s *Should) HaveSameType(expected, actual interface{}, assumption ...string) {
+	defer s.track("HaveSameType", assumption)()
+
 	expectedType := reflect.TypeOf(expected)
 	actualType := reflect.TypeOf(actual)
 
 	if expectedType != actualType {
 		s.t.Helper()
-		s.t.Log(fmt.Sprintf(valuesLogFormat, assumption, "HaveSameType", expectedType, actualType))
+		s.t.Log(fmt.Sprintf(valuesLogFormat, describe(s.assumed(assumption), "HaveSameType", "%[2]s has the same type as %[1]s"), "HaveSameType", expectedType, actualType))
 		s.t.Fail()
 	}
 }
 
 // HaveSameItems compares two arrays and fails the test when they don't have the same items, regardless of the ordering.
-func (s *Should) HaveSameItems(expected, actual interface{}, assumption string) {
+func (s *Should) HaveSameItems(expected, actual interface{}, assumption ...string) {
+	defer s.track("HaveSameItems", assumption)()
+
 	expectedType := reflect.TypeOf(expected)
 	actualType := reflect.TypeOf(actual)
 	if expectedType != actualType {
 		s.t.Helper()
-		s.t.Log(fmt.Sprintf(reasonLogFormat, assumption, "HaveSameItems", "type mismatch", expectedType, actualType))
+		s.t.Log(fmt.Sprintf(reasonLogFormat, describe(s.assumed(assumption), "HaveSameItems", sameItemsTemplate), "HaveSameItems", "type mismatch", expectedType, actualType))
 		s.t.Fail()
 		return
 	}
@@ -136,7 +211,7 @@ func (s *Should) HaveSameItems(expected, actual interface{}, assumption string)
 
 		if v1.Len() != v2.Len() {
 			s.t.Helper()
-			s.t.Log(fmt.Sprintf(lengthMismatchLogFormat, assumption, "HaveSameItems", "length mismatch", v1, v2, v1.Len(), v2.Len()))
+			s.t.Log(fmt.Sprintf(lengthMismatchLogFormat, describe(s.assumed(assumption), "HaveSameItems", sameItemsTemplate), "HaveSameItems", "length mismatch", v1, v2, v1.Len(), v2.Len()))
 			s.t.Fail()
 			return
 		}
@@ -144,7 +219,7 @@ func (s *Should) HaveSameItems(expected, actual interface{}, assumption string)
 		missingItems := getMissingItems(v1, v2)
 		if len(missingItems) > 0 {
 			s.t.Helper()
-			s.t.Log(fmt.Sprintf(missingItemsLogFormat, assumption, "HaveSameItems", "items missing", v1, v2, missingItems))
+			s.t.Log(fmt.Sprintf(missingItemsLogFormat, describe(s.assumed(assumption), "HaveSameItems", sameItemsTemplate), "HaveSameItems", "items missing", v1, v2, missingItems))
 			s.t.Fail()
 		}
 	}
@@ -3,6 +3,7 @@ package should
 import (
 	"errors"
 	"fmt"
+	"strings"
 	"testing"
 )
 
@@ -28,6 +29,53 @@ func (t *testingStub) Fail() {
 	t.hasFailed = true
 }
 
+func TestAssert(t *testing.T) {
+	fixJUnitEnvironment(t)
+	r := NewJUnitReporter()
+	stub := namedStub{name: "TestCustom"}
+	should := New(&stub, WithJUnit(r))
+
+	beEven := func(n int) func(string) string {
+		return func(assumption string) string {
+			if n%2 == 0 {
+				return ""
+			}
+			return Failure(assumption, "BeEven", "expected", "even", "actual", n)
+		}
+	}
+
+	should.Assert("BeEven", beEven(2), "2 is even")
+	should.Assert("BeEven", beEven(3), "3 is even")
+
+	if !stub.hasFailed || !stub.WasHelperCalled() {
+		t.Error("test was expected to fail and call Helper()")
+	}
+	if expected := "\nassumption: [ 3 is even ]\n    should: BeEven \n  expected: even\n    actual: 3"; stub.logMessage != expected {
+		t.Errorf("wanted '%s' got '%s'", expected, stub.logMessage)
+	}
+
+	should.Assert("BeEven", beEven(5))
+	if expected := "\nassumption: [ BeEven ]\n    should: BeEven \n  expected: even\n    actual: 5"; stub.logMessage != expected {
+		t.Errorf("wanted '%s' got '%s'", expected, stub.logMessage)
+	}
+
+	should.Because("7 is even").Assert("BeEven", beEven(7))
+	if expected := "\nassumption: [ 7 is even ]\n    should: BeEven \n  expected: even\n    actual: 7"; stub.logMessage != expected {
+		t.Errorf("wanted '%s' got '%s'", expected, stub.logMessage)
+	}
+
+	var out strings.Builder
+	_, _ = r.WriteTo(&out)
+	for _, expected := range []string{
+		`<testcase name="2 is even" classname="TestCustom" time="0.000"></testcase>`,
+		`<failure message="3 is even" type="BeEven">`,
+	} {
+		if !strings.Contains(out.String(), expected) {
+			t.Errorf("wanted report containing '%s' got '%s'", expected, out.String())
+		}
+	}
+}
+
 func TestBeNil(t *testing.T) {
 	t.Run("scenarios that must fail tests", func(t *testing.T) {
 		assertThat := func(assumption string, value interface{}) {
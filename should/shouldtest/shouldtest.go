@@ -0,0 +1,222 @@
+// Package shouldtest provide helpers for testing custom assertions built on top of should.
+package shouldtest
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/pjbgf/go-test/should"
+)
+
+var escaper = strings.NewReplacer("\n", "\\n", "\t", "\\t")
+
+// Recorder is a test double for should.TestingT, including all of its optional capabilities.
+// It captures every message logged and records how the test was failed, without stopping it.
+// The zero value is ready to use.
+type Recorder struct {
+	mu          sync.Mutex
+	name        string
+	logs        []string
+	failed      bool
+	failedNow   bool
+	helperCalls int
+	cleanups    []func()
+}
+
+// NewRecorder initialises a new Recorder whose Name returns name.
+func NewRecorder(name string) *Recorder {
+	return &Recorder{name: name}
+}
+
+// Helper records the call.
+func (r *Recorder) Helper() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.helperCalls++
+}
+
+// Log captures args as a single line.
+func (r *Recorder) Log(args ...interface{}) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.logs = append(r.logs, fmt.Sprint(args...))
+}
+
+// Logf captures the formatted line.
+func (r *Recorder) Logf(format string, args ...interface{}) {
+	r.Log(fmt.Sprintf(format, args...))
+}
+
+// Error captures args and marks the test as failed.
+func (r *Recorder) Error(args ...interface{}) {
+	r.Log(args...)
+	r.Fail()
+}
+
+// Errorf captures the formatted line and marks the test as failed.
+func (r *Recorder) Errorf(format string, args ...interface{}) {
+	r.Logf(format, args...)
+	r.Fail()
+}
+
+// Fatal captures args and records a FailNow.
+func (r *Recorder) Fatal(args ...interface{}) {
+	r.Log(args...)
+	r.FailNow()
+}
+
+// Fatalf captures the formatted line and records a FailNow.
+func (r *Recorder) Fatalf(format string, args ...interface{}) {
+	r.Logf(format, args...)
+	r.FailNow()
+}
+
+// Fail marks the test as failed.
+func (r *Recorder) Fail() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.failed = true
+}
+
+// FailNow marks the test as failed and records that it was asked to stop. Unlike testing.T, it does not stop the caller.
+func (r *Recorder) FailNow() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.failed, r.failedNow = true, true
+}
+
+// Name returns the name given to NewRecorder.
+func (r *Recorder) Name() string {
+	return r.name
+}
+
+// Cleanup registers fn to be run by RunCleanups.
+func (r *Recorder) Cleanup(fn func()) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.cleanups = append(r.cleanups, fn)
+}
+
+// RunCleanups runs the functions registered with Cleanup, last registered first.
+func (r *Recorder) RunCleanups() {
+	r.mu.Lock()
+	cleanups := r.cleanups
+	r.cleanups = nil
+	r.mu.Unlock()
+
+	for i := len(cleanups) - 1; i >= 0; i-- {
+		cleanups[i]()
+	}
+}
+
+// TempDir creates a new temporary directory, which is removed by RunCleanups.
+// It panics if the directory cannot be created.
+func (r *Recorder) TempDir() string {
+	dir, err := ioutil.TempDir("", "shouldtest")
+	if err != nil {
+		panic(err)
+	}
+
+	r.Cleanup(func() { os.RemoveAll(dir) })
+	return dir
+}
+
+// Failed reports whether Fail or FailNow was called.
+func (r *Recorder) Failed() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.failed
+}
+
+// FailedNow reports whether FailNow was called.
+func (r *Recorder) FailedNow() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.failedNow
+}
+
+// HelperCalls returns how many times Helper was called.
+func (r *Recorder) HelperCalls() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.helperCalls
+}
+
+// Logs returns every line captured, in order.
+func (r *Recorder) Logs() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]string(nil), r.logs...)
+}
+
+// Output returns every line captured, joined by new lines.
+func (r *Recorder) Output() string {
+	return strings.Join(r.Logs(), "\n")
+}
+
+// Fails fails the test unless assertion, run against a new Recorder, failed with exactly the expected
+// output and called Helper, so failures are reported at the caller of the custom assertion.
+func Fails(t should.TestingT, assertion func(r *Recorder), expected string, assumption ...string) {
+	t.Helper()
+	r := &Recorder{}
+	assertion(r)
+
+	should.New(t).Because("assertion fails with message").Assert("Fails", func(assumption string) string {
+		if reason, expectedValue, actualValue, ok := checkFailure(r); !ok {
+			return should.Failure(assumption, "Fails", "reason", reason, "expected", expectedValue, "actual", actualValue)
+		}
+		if output := r.Output(); output != expected {
+			return should.Failure(assumption, "Fails",
+				"reason", "output differs", "expected", escaper.Replace(expected), "actual", escaper.Replace(output))
+		}
+		return ""
+	}, assumption...)
+}
+
+// FailsContaining fails the test unless assertion, run against a new Recorder, failed with an output
+// containing expected and called Helper.
+func FailsContaining(t should.TestingT, assertion func(r *Recorder), expected string, assumption ...string) {
+	t.Helper()
+	r := &Recorder{}
+	assertion(r)
+
+	should.New(t).Because("assertion fails with message containing").Assert("FailsContaining", func(assumption string) string {
+		if reason, expectedValue, actualValue, ok := checkFailure(r); !ok {
+			return should.Failure(assumption, "FailsContaining", "reason", reason, "expected", expectedValue, "actual", actualValue)
+		}
+		if output := r.Output(); !strings.Contains(output, expected) {
+			return should.Failure(assumption, "FailsContaining",
+				"reason", "output does not contain expected", "expected", escaper.Replace(expected), "actual", escaper.Replace(output))
+		}
+		return ""
+	}, assumption...)
+}
+
+// Passes fails the test if assertion, run against a new Recorder, failed or logged anything.
+func Passes(t should.TestingT, assertion func(r *Recorder), assumption ...string) {
+	t.Helper()
+	r := &Recorder{}
+	assertion(r)
+
+	should.New(t).Because("assertion passes").Assert("Passes", func(assumption string) string {
+		if r.Failed() || len(r.Logs()) > 0 {
+			return should.Failure(assumption, "Passes",
+				"reason", "assertion failed or logged", "expected", "no output", "actual", escaper.Replace(r.Output()))
+		}
+		return ""
+	}, assumption...)
+}
+
+// checkFailure reports whether r failed after calling Helper, and why not otherwise.
+func checkFailure(r *Recorder) (reason string, expected, actual interface{}, ok bool) {
+	if !r.Failed() {
+		return "assertion did not fail", "failed", "passed", false
+	}
+	if r.HelperCalls() == 0 {
+		return "assertion did not call Helper()", "at least 1 call", "0 calls", false
+	}
+	return "", nil, nil, true
+}
@@ -0,0 +1,159 @@
+package shouldtest
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/pjbgf/go-test/should"
+)
+
+var (
+	_ should.TestingT = (*Recorder)(nil)
+	_ should.FailNowT = (*Recorder)(nil)
+	_ should.NameT    = (*Recorder)(nil)
+	_ should.CleanupT = (*Recorder)(nil)
+	_ should.TempDirT = (*Recorder)(nil)
+)
+
+// beEven is a custom assertion, as teams would write on top of should.
+func beEven(t should.TestingT, n int) {
+	t.Helper()
+	should.New(t).BeTrue(n%2 == 0, "number is even")
+}
+
+// beEvenWithoutHelper forgets to call Helper, so failures point at the wrong line.
+func beEvenWithoutHelper(t should.TestingT, n int) {
+	if n%2 != 0 {
+		t.Log("odd number")
+		t.Fail()
+	}
+}
+
+type mainStub func() int
+
+func (m mainStub) Run() int {
+	return m()
+}
+
+func TestRecorder(t *testing.T) {
+	r := NewRecorder("TestSomething")
+	var s should.TestingT = r
+	s.Helper()
+	r.Logf("%d", 1)
+	r.Error("two")
+	r.Fatalf("%s", "three")
+
+	if !r.Failed() || !r.FailedNow() {
+		t.Error("Fail() and FailNow() calls were expected to be recorded")
+	}
+	if r.HelperCalls() != 1 {
+		t.Errorf("wanted 1 Helper() call got %d", r.HelperCalls())
+	}
+	if expected := []string{"1", "two", "three"}; !reflect.DeepEqual(expected, r.Logs()) {
+		t.Errorf("wanted '%v' got '%v'", expected, r.Logs())
+	}
+	if r.Output() != "1\ntwo\nthree" {
+		t.Errorf("wanted '1\\ntwo\\nthree' got '%s'", r.Output())
+	}
+	if r.Name() != "TestSomething" {
+		t.Errorf("wanted 'TestSomething' got '%s'", r.Name())
+	}
+
+	var order []int
+	r.Cleanup(func() { order = append(order, 1) })
+	r.Cleanup(func() { order = append(order, 2) })
+	dir := r.TempDir()
+	r.RunCleanups()
+
+	if !reflect.DeepEqual([]int{2, 1}, order) {
+		t.Errorf("wanted cleanups to run in reverse order got %v", order)
+	}
+	if _, err := os.Stat(dir); !os.IsNotExist(err) {
+		t.Errorf("wanted '%s' to be removed: %v", dir, err)
+	}
+}
+
+func TestRecorderFailsOnly(t *testing.T) {
+	r := &Recorder{}
+	r.Fail()
+
+	if !r.Failed() || r.FailedNow() {
+		t.Error("only Fail() call was expected to be recorded")
+	}
+}
+
+func TestFails(t *testing.T) {
+	t.Run("scenarios that must fail tests", func(t *testing.T) {
+		assertThat := func(assumption string, check func(*Recorder), expectedLogMessage string) {
+			r := &Recorder{}
+
+			check(r)
+
+			if !r.Failed() {
+				t.Error("test was expected to fail but did not")
+			}
+			if r.HelperCalls() == 0 {
+				t.Error("Helper() call was expected but did not happen")
+			}
+			if expectedLogMessage != r.Output() {
+				t.Errorf("wanted '%s' got '%s'", expectedLogMessage, r.Output())
+			}
+		}
+
+		assertThat("should fail when the assertion passes",
+			func(r *Recorder) { Fails(r, func(a *Recorder) { beEven(a, 2) }, "", "fails for 2") },
+			"\nassumption: [ fails for 2 ]\n    should: Fails \n    reason: assertion did not fail\n  expected: failed\n    actual: passed")
+		assertThat("should fail when Helper is not called",
+			func(r *Recorder) { Fails(r, func(a *Recorder) { beEvenWithoutHelper(a, 3) }, "odd number") },
+			"\nassumption: [ assertion fails with message ]\n    should: Fails \n    reason: assertion did not call Helper()"+
+				"\n  expected: at least 1 call\n    actual: 0 calls")
+		assertThat("should fail when the message differs",
+			func(r *Recorder) { Fails(r, func(a *Recorder) { beEven(a, 3) }, "odd", "fails for 3") },
+			"\nassumption: [ fails for 3 ]\n    should: Fails \n    reason: output differs\n  expected: odd"+
+				"\n    actual: \\nassumption: [ number is even ]\\n    should: BeTrue \\n  expected: true\\n    actual: false")
+		assertThat("should fail when the message does not contain expected",
+			func(r *Recorder) { FailsContaining(r, func(a *Recorder) { beEven(a, 3) }, "BeFalse") },
+			"\nassumption: [ assertion fails with message containing ]\n    should: FailsContaining \n    reason: output does not contain expected"+
+				"\n  expected: BeFalse\n    actual: \\nassumption: [ number is even ]\\n    should: BeTrue \\n  expected: true\\n    actual: false")
+		assertThat("should fail when a passing assertion logs",
+			func(r *Recorder) { Passes(r, func(a *Recorder) { a.Log("debug") }, "passes silently") },
+			"\nassumption: [ passes silently ]\n    should: Passes \n    reason: assertion failed or logged\n  expected: no output\n    actual: debug")
+	})
+
+	t.Run("scenarios that must not fail tests", func(t *testing.T) {
+		Fails(t, func(r *Recorder) { beEven(r, 3) },
+			"\nassumption: [ number is even ]\n    should: BeTrue \n  expected: true\n    actual: false",
+			"should fail for odd numbers")
+		FailsContaining(t, func(r *Recorder) { beEven(r, 5) }, "number is even", "should mention the assumption")
+		Passes(t, func(r *Recorder) { beEven(r, 4) }, "should pass for even numbers")
+	})
+}
+
+func TestReporting(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "junit.xml")
+
+	should.RunWithJUnit(mainStub(func() int {
+		r := NewRecorder("TestCustom")
+		Fails(r, func(a *Recorder) { beEven(a, 3) }, "odd", "fails for 3")
+		Passes(r, func(a *Recorder) { beEven(a, 2) })
+		return 0
+	}), path)
+
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, expected := range []string{
+		`<testcase name="fails for 3" classname="TestCustom"`,
+		`<failure message="fails for 3" type="Fails">`,
+		`<testcase name="assertion passes" classname="TestCustom"`,
+	} {
+		if !strings.Contains(string(content), expected) {
+			t.Errorf("wanted report containing '%s' got '%s'", expected, content)
+		}
+	}
+}
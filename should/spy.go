@@ -0,0 +1,264 @@
+package should
+
+import (
+	"fmt"
+	"reflect"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+const spyLogFormat string = "\nassumption: [ %s ]\n    should: %s \n  expected: %v\n    actual: %v\n     calls: %s"
+
+// spySequence orders calls across all spies, for HaveBeenCalledInOrder.
+var spySequence uint64
+
+// FuncSpy records the invocations of a function wrapped by Spy.
+// It is safe for concurrent use.
+type FuncSpy struct {
+	mu       sync.Mutex
+	name     string
+	funcType reflect.Type
+	calls    []spyCall
+	results  []reflect.Value
+}
+
+type spyCall struct {
+	sequence uint64
+	args     []interface{}
+}
+
+// Spy wraps fn, which must be a function, and returns the wrapper together with the FuncSpy recording its calls.
+// The wrapper calls fn, unless fn is nil or results were overridden with FuncSpy.Return,
+// in which case it returns those results or zero values. Variadic arguments are recorded as a single slice.
+func Spy[F any](fn F) (F, *FuncSpy) {
+	value := reflect.ValueOf(&fn).Elem()
+	if value.Kind() != reflect.Func {
+		panic(fmt.Sprintf("should: Spy requires a function, got %s", value.Type()))
+	}
+
+	spy := &FuncSpy{name: value.Type().String(), funcType: value.Type()}
+	if !value.IsNil() {
+		if f := runtime.FuncForPC(value.Pointer()); f != nil {
+			spy.name = f.Name()[strings.LastIndex(f.Name(), "/")+1:]
+		}
+	}
+
+	wrapper := reflect.MakeFunc(value.Type(), func(in []reflect.Value) []reflect.Value {
+		results := spy.record(in)
+		if results != nil {
+			return results
+		}
+
+		if value.IsNil() {
+			return zeroResults(value.Type())
+		}
+		if value.Type().IsVariadic() {
+			return value.CallSlice(in)
+		}
+		return value.Call(in)
+	})
+
+	return wrapper.Interface().(F), spy
+}
+
+// Return overrides the results of every following call. It panics if results do not match the function's results.
+func (s *FuncSpy) Return(results ...interface{}) *FuncSpy {
+	if len(results) != s.funcType.NumOut() {
+		panic(fmt.Sprintf("should: %s returns %d values, got %d", s.name, s.funcType.NumOut(), len(results)))
+	}
+
+	values := make([]reflect.Value, len(results))
+	for i, result := range results {
+		out := s.funcType.Out(i)
+		if result == nil {
+			values[i] = reflect.Zero(out)
+			continue
+		}
+
+		values[i] = reflect.ValueOf(result)
+		if !values[i].Type().AssignableTo(out) {
+			panic(fmt.Sprintf("should: result %d of %s must be %s, got %T", i, s.name, out, result))
+		}
+		values[i] = values[i].Convert(out)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.results = values
+	return s
+}
+
+// Calls returns the arguments of every call recorded so far, in order.
+func (s *FuncSpy) Calls() [][]interface{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	calls := make([][]interface{}, len(s.calls))
+	for i, call := range s.calls {
+		calls[i] = call.args
+	}
+	return calls
+}
+
+// String returns the name of the function being spied on.
+func (s *FuncSpy) String() string {
+	return s.name
+}
+
+func (s *FuncSpy) record(in []reflect.Value) []reflect.Value {
+	args := make([]interface{}, len(in))
+	for i, arg := range in {
+		args[i] = arg.Interface()
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.calls = append(s.calls, spyCall{sequence: atomic.AddUint64(&spySequence, 1), args: args})
+	return s.results
+}
+
+func (s *FuncSpy) snapshot() []spyCall {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]spyCall(nil), s.calls...)
+}
+
+// describeCalls lists every call recorded by spy, one per line.
+func (s *FuncSpy) describeCalls() string {
+	calls := s.snapshot()
+	lines := make([]string, len(calls))
+	for i, call := range calls {
+		lines[i] = s.describeCall(call)
+	}
+	return listOrNone(lines)
+}
+
+func (s *FuncSpy) describeCall(call spyCall) string {
+	args := make([]string, len(call.args))
+	for i, arg := range call.args {
+		args[i] = fmt.Sprintf("%#v", arg)
+	}
+
+	return s.name + "(" + strings.Join(args, ", ") + ")"
+}
+
+func countCalls(n int) string {
+	if n == 1 {
+		return "1 call"
+	}
+	return fmt.Sprintf("%d calls", n)
+}
+
+func zeroResults(funcType reflect.Type) []reflect.Value {
+	results := make([]reflect.Value, funcType.NumOut())
+	for i := range results {
+		results[i] = reflect.Zero(funcType.Out(i))
+	}
+	return results
+}
+
+// HaveBeenCalled fails the test if spy has not recorded any call.
+func (s *Should) HaveBeenCalled(spy *FuncSpy, assumption ...string) {
+	defer s.track("HaveBeenCalled", assumption)()
+
+	if len(spy.snapshot()) == 0 {
+		s.t.Helper()
+		s.t.Log(fmt.Sprintf(spyLogFormat, describe(s.assumed(assumption), "HaveBeenCalled", "%[1]s has been called"), "HaveBeenCalled",
+			"at least 1 call", countCalls(0), spy.describeCalls()))
+		s.t.Fail()
+	}
+}
+
+// HaveBeenCalledTimes fails the test if spy has not recorded exactly n calls.
+func (s *Should) HaveBeenCalledTimes(spy *FuncSpy, n int, assumption ...string) {
+	defer s.track("HaveBeenCalledTimes", assumption)()
+
+	if calls := len(spy.snapshot()); calls != n {
+		s.t.Helper()
+		s.t.Log(fmt.Sprintf(spyLogFormat, describe(s.assumed(assumption), "HaveBeenCalledTimes", "%[1]s has been called %[2]s times"), "HaveBeenCalledTimes",
+			countCalls(n), countCalls(calls), spy.describeCalls()))
+		s.t.Fail()
+	}
+}
+
+// HaveBeenCalledWith fails the test if none of the calls recorded by spy had exactly args.
+// Variadic arguments must be given as a single slice. Use Because to describe the assertion.
+func (s *Should) HaveBeenCalledWith(spy *FuncSpy, args ...interface{}) {
+	defer s.track("HaveBeenCalledWith", nil)()
+
+	if args == nil {
+		// spies record calls without arguments as an empty slice
+		args = []interface{}{}
+	}
+	for _, call := range spy.snapshot() {
+		if reflect.DeepEqual(args, call.args) {
+			return
+		}
+	}
+
+	s.t.Helper()
+	call := spy.describeCall(spyCall{args: args})
+	s.t.Log(fmt.Sprintf(spyLogFormat, describe(s.because, "HaveBeenCalledWith", call+" has been called"), "HaveBeenCalledWith",
+		call, "no matching call", spy.describeCalls()))
+	s.t.Fail()
+}
+
+// HaveBeenCalledInOrder fails the test if spies were not called in the given order.
+// Other calls may happen in between; each spy must have a call that follows the one matched for the previous spy.
+// Use Because to describe the assertion.
+func (s *Should) HaveBeenCalledInOrder(spies ...*FuncSpy) {
+	defer s.track("HaveBeenCalledInOrder", nil)()
+
+	var all []spyCall
+	owners := make(map[uint64]*FuncSpy)
+	for _, spy := range spies {
+		for _, call := range spy.snapshot() {
+			if _, seen := owners[call.sequence]; !seen {
+				owners[call.sequence] = spy
+				all = append(all, call)
+			}
+		}
+	}
+
+	var last uint64
+	for _, spy := range spies {
+		next, found := uint64(0), false
+		for _, call := range spy.snapshot() {
+			if call.sequence > last {
+				next, found = call.sequence, true
+				break
+			}
+		}
+
+		if !found {
+			s.t.Helper()
+			s.t.Log(fmt.Sprintf(spyLogFormat, describe(s.because, "HaveBeenCalledInOrder", spyNames(spies)+" have been called in order"), "HaveBeenCalledInOrder",
+				spyNames(spies), "no call to "+spy.name+" after the previous one", describeOrderedCalls(all, owners)))
+			s.t.Fail()
+			return
+		}
+		last = next
+	}
+}
+
+func spyNames(spies []*FuncSpy) string {
+	names := make([]string, len(spies))
+	for i, spy := range spies {
+		names[i] = spy.name
+	}
+	return strings.Join(names, ", ")
+}
+
+// describeOrderedCalls lists calls from several spies in the order they happened.
+func describeOrderedCalls(calls []spyCall, owners map[uint64]*FuncSpy) string {
+	sort.Slice(calls, func(i, j int) bool { return calls[i].sequence < calls[j].sequence })
+	lines := make([]string, len(calls))
+	for i, call := range calls {
+		lines[i] = owners[call.sequence].describeCall(call)
+	}
+	return listOrNone(lines)
+}
@@ -0,0 +1,230 @@
+package should
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func notify(ctx context.Context, msg string) error {
+	if msg == "" {
+		return errors.New("empty message")
+	}
+	return nil
+}
+
+func join(sep string, parts ...string) string {
+	s := ""
+	for i, part := range parts {
+		if i > 0 {
+			s += sep
+		}
+		s += part
+	}
+	return s
+}
+
+func TestSpy(t *testing.T) {
+	t.Run("wraps the function", func(t *testing.T) {
+		fn, spy := Spy(notify)
+
+		if err := fn(context.TODO(), ""); err == nil || err.Error() != "empty message" {
+			t.Errorf("wanted 'empty message' got '%v'", err)
+		}
+		if got := spy.String(); got != "should.notify" {
+			t.Errorf("wanted 'should.notify' got '%s'", got)
+		}
+		if calls := spy.Calls(); len(calls) != 1 || calls[0][1] != "" {
+			t.Errorf("wanted 1 call with an empty message got %v", calls)
+		}
+	})
+
+	t.Run("overrides results", func(t *testing.T) {
+		fn, spy := Spy(notify)
+		spy.Return(errors.New("unavailable"))
+
+		if err := fn(context.TODO(), "hello"); err == nil || err.Error() != "unavailable" {
+			t.Errorf("wanted 'unavailable' got '%v'", err)
+		}
+
+		spy.Return(nil)
+		if err := fn(context.TODO(), ""); err != nil {
+			t.Errorf("wanted no error got '%v'", err)
+		}
+	})
+
+	t.Run("returns zero values for nil functions", func(t *testing.T) {
+		fn, spy := Spy((func(int) (string, error))(nil))
+
+		if s, err := fn(1); s != "" || err != nil {
+			t.Errorf("wanted zero values got '%s', '%v'", s, err)
+		}
+		if got := spy.String(); got != "func(int) (string, error)" {
+			t.Errorf("wanted 'func(int) (string, error)' got '%s'", got)
+		}
+	})
+
+	t.Run("records variadic arguments as a slice", func(t *testing.T) {
+		fn, spy := Spy(join)
+
+		if got := fn("-", "a", "b"); got != "a-b" {
+			t.Errorf("wanted 'a-b' got '%s'", got)
+		}
+		if calls := spy.Calls(); len(calls) != 1 || len(calls[0]) != 2 {
+			t.Errorf("wanted 1 call with 2 arguments got %v", calls)
+		}
+	})
+
+	t.Run("panics for invalid use", func(t *testing.T) {
+		assertPanics := func(name string, fn func()) {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%s: panic was expected but did not happen", name)
+				}
+			}()
+			fn()
+		}
+
+		assertPanics("not a function", func() { Spy(42) })
+		assertPanics("wrong result count", func() {
+			_, spy := Spy(notify)
+			spy.Return(nil, nil)
+		})
+		assertPanics("wrong result type", func() {
+			_, spy := Spy(notify)
+			spy.Return("unavailable")
+		})
+	})
+}
+
+func TestHaveBeenCalled(t *testing.T) {
+	t.Run("scenarios that must fail tests", func(t *testing.T) {
+		assertThat := func(assumption string, check func(*Should), expectedLogMessage string) {
+			stub := testingStub{}
+			should := New(&stub)
+
+			check(should)
+
+			if !stub.hasFailed {
+				t.Error("test was expected to fail but did not")
+			}
+			if !stub.WasHelperCalled() {
+				t.Error("Helper() call was expected but did not happen")
+			}
+			if expectedLogMessage != stub.logMessage {
+				t.Errorf("wanted '%s' got '%s'", expectedLogMessage, stub.logMessage)
+			}
+		}
+
+		assertThat("should fail when the function was not called",
+			func(s *Should) {
+				_, spy := Spy(notify)
+				s.HaveBeenCalled(spy, "notifies")
+			},
+			"\nassumption: [ notifies ]\n    should: HaveBeenCalled \n  expected: at least 1 call\n    actual: 0 calls\n     calls: none")
+		assertThat("should fail for a different number of calls",
+			func(s *Should) {
+				fn, spy := Spy(notify)
+				fn(nil, "a")
+				fn(nil, "b")
+				s.HaveBeenCalledTimes(spy, 1, "notifies once")
+			},
+			"\nassumption: [ notifies once ]\n    should: HaveBeenCalledTimes \n  expected: 1 call\n    actual: 2 calls"+
+				"\n     calls: should.notify(<nil>, \"a\")"+
+				"\n            should.notify(<nil>, \"b\")")
+		assertThat("should fail when no call has the arguments",
+			func(s *Should) {
+				fn, spy := Spy(join)
+				fn(",", "a")
+				s.Because("joins a and b").HaveBeenCalledWith(spy, ",", []string{"a", "b"})
+			},
+			"\nassumption: [ joins a and b ]\n    should: HaveBeenCalledWith \n"+
+				"  expected: should.join(\",\", []string{\"a\", \"b\"})\n    actual: no matching call"+
+				"\n     calls: should.join(\",\", []string{\"a\"})")
+		assertThat("should fail when calls are out of order",
+			func(s *Should) {
+				first, firstSpy := Spy(notify)
+				second, secondSpy := Spy(join)
+				second("-")
+				first(nil, "done")
+				s.Because("notifies then joins").HaveBeenCalledInOrder(firstSpy, secondSpy)
+			},
+			"\nassumption: [ notifies then joins ]\n    should: HaveBeenCalledInOrder \n"+
+				"  expected: should.notify, should.join\n    actual: no call to should.join after the previous one"+
+				"\n     calls: should.join(\"-\", []string(nil))"+
+				"\n            should.notify(<nil>, \"done\")")
+		assertThat("should derive the assumption from the call",
+			func(s *Should) {
+				_, spy := Spy(notify)
+				s.HaveBeenCalledTimes(spy, 2)
+			},
+			"\nassumption: [ spy has been called 2 times ]\n    should: HaveBeenCalledTimes \n  expected: 2 calls\n    actual: 0 calls\n     calls: none")
+		assertThat("should derive the assumption from the expected call",
+			func(s *Should) {
+				_, spy := Spy(notify)
+				s.HaveBeenCalledWith(spy, context.Context(nil), "a")
+			},
+			"\nassumption: [ should.notify(<nil>, \"a\") has been called ]\n    should: HaveBeenCalledWith \n"+
+				"  expected: should.notify(<nil>, \"a\")\n    actual: no matching call\n     calls: none")
+		assertThat("should derive the assumption from the spies",
+			func(s *Should) {
+				_, firstSpy := Spy(notify)
+				_, secondSpy := Spy(join)
+				s.HaveBeenCalledInOrder(firstSpy, secondSpy)
+			},
+			"\nassumption: [ should.notify, should.join have been called in order ]\n    should: HaveBeenCalledInOrder \n"+
+				"  expected: should.notify, should.join\n    actual: no call to should.notify after the previous one\n     calls: none")
+	})
+
+	t.Run("scenarios that must not fail tests", func(t *testing.T) {
+		assertThat := func(assumption string, check func(*Should)) {
+			stub := testingStub{}
+			should := New(&stub)
+
+			check(should)
+
+			if stub.hasFailed {
+				t.Error("test was expected to not fail but it did")
+			}
+			if stub.WasHelperCalled() {
+				t.Error("Helper() call was not expected")
+			}
+			if stub.logMessage != "" {
+				t.Errorf("wanted '%s' got '%s'", "", stub.logMessage)
+			}
+		}
+
+		assertThat("should not fail for calls that meet the expectations", func(s *Should) {
+			fn, spy := Spy(notify)
+			fn(nil, "a")
+			fn(nil, "b")
+
+			s.HaveBeenCalled(spy, "notifies")
+			s.HaveBeenCalledTimes(spy, 2, "notifies twice")
+			s.Because("notifies b").HaveBeenCalledWith(spy, context.Context(nil), "b")
+		})
+		assertThat("should not fail for calls without arguments", func(s *Should) {
+			fn, spy := Spy(func() {})
+			fn()
+
+			s.HaveBeenCalledWith(spy)
+		})
+		assertThat("should not fail for variadic arguments given as a slice", func(s *Should) {
+			fn, spy := Spy(join)
+			fn("-", "a", "b")
+
+			s.Because("joins a and b").HaveBeenCalledWith(spy, "-", []string{"a", "b"})
+		})
+		assertThat("should not fail for calls in order with others in between", func(s *Should) {
+			first, firstSpy := Spy(notify)
+			second, secondSpy := Spy(join)
+			second("-")
+			first(nil, "a")
+			first(nil, "b")
+			second("-")
+
+			s.HaveBeenCalledInOrder(firstSpy, secondSpy)
+			s.HaveBeenCalledInOrder(secondSpy, firstSpy, secondSpy)
+		})
+	})
+}
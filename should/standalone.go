@@ -0,0 +1,118 @@
+package should
+
+import (
+	"fmt"
+	"io"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+)
+
+// Standalone is a TestingT for code that runs outside of go test, such as examples,
+// smoke tests against a live environment or self-checks of a command. It records
+// failures in memory and writes every message logged to its writer, prefixed with
+// the location of the failing assertion, as go test does.
+//
+//	runner := should.NewStandalone(os.Stderr)
+//	should := should.New(runner)
+//	should.BeEqual(http.StatusOK, resp.StatusCode, "health check responds")
+//	if runner.Failed() {
+//		fmt.Fprintln(os.Stderr, runner.Summary())
+//		os.Exit(1)
+//	}
+type Standalone struct {
+	mu       sync.Mutex
+	w        io.Writer
+	helpers  map[string]bool
+	pending  []string
+	failures []string
+}
+
+// NewStandalone initialises a new Standalone that writes messages to w, which can be nil.
+func NewStandalone(w io.Writer) *Standalone {
+	return &Standalone{w: w, helpers: make(map[string]bool)}
+}
+
+// Helper marks the calling function as a helper, so it is skipped when locating failures.
+func (s *Standalone) Helper() {
+	pc, _, _, ok := runtime.Caller(1)
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.helpers[runtime.FuncForPC(pc).Name()] = true
+}
+
+// Log writes args to the writer and keeps them for the next failure.
+func (s *Standalone) Log(args ...interface{}) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	message := fmt.Sprint(args...)
+	s.pending = append(s.pending, message)
+	if s.w != nil {
+		fmt.Fprintf(s.w, "%s: %s\n", s.location(), strings.ReplaceAll(message, "\n", "\n    "))
+	}
+}
+
+// Fail records a failure made of the messages logged since the previous one.
+func (s *Standalone) Fail() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.failures = append(s.failures, strings.Join(s.pending, "\n"))
+	s.pending = nil
+}
+
+func (s *Standalone) discardPending() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.pending = nil
+}
+
+// Failed reports whether any failure was recorded.
+func (s *Standalone) Failed() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return len(s.failures) > 0
+}
+
+// Failures returns the messages of every failure recorded, in order.
+func (s *Standalone) Failures() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]string(nil), s.failures...)
+}
+
+// Summary describes how many failures were recorded, e.g. "2 assertions failed".
+func (s *Standalone) Summary() string {
+	switch n := len(s.Failures()); n {
+	case 0:
+		return "all assertions passed"
+	case 1:
+		return "1 assertion failed"
+	default:
+		return fmt.Sprintf("%d assertions failed", n)
+	}
+}
+
+// location returns file:line of the first caller that is not a helper.
+func (s *Standalone) location() string {
+	pcs := make([]uintptr, 32)
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(3, pcs)])
+	for {
+		frame, more := frames.Next()
+		if !s.helpers[frame.Function] {
+			return fmt.Sprintf("%s:%d", filepath.Base(frame.File), frame.Line)
+		}
+		if !more {
+			return "???"
+		}
+	}
+}
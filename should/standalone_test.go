@@ -0,0 +1,84 @@
+package should
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestStandalone(t *testing.T) {
+	t.Run("records failures", func(t *testing.T) {
+		var out bytes.Buffer
+		runner := NewStandalone(&out)
+		should := New(runner)
+
+		should.BeTrue(true, "passes")
+		should.BeTrue(false, "first failure")
+		should.BeFalse(true, "second failure")
+
+		expected := []string{
+			"\nassumption: [ first failure ]\n    should: BeTrue \n  expected: true\n    actual: false",
+			"\nassumption: [ second failure ]\n    should: BeFalse \n  expected: false\n    actual: true",
+		}
+		if !runner.Failed() {
+			t.Error("runner was expected to fail but did not")
+		}
+		if !reflect.DeepEqual(expected, runner.Failures()) {
+			t.Errorf("wanted '%v' got '%v'", expected, runner.Failures())
+		}
+		if summary := runner.Summary(); summary != "2 assertions failed" {
+			t.Errorf("wanted '2 assertions failed' got '%s'", summary)
+		}
+	})
+
+	t.Run("writes messages with the location of the assertion", func(t *testing.T) {
+		var out bytes.Buffer
+		runner := NewStandalone(&out)
+		should := New(runner)
+
+		should.BeTrue(false, "fails")
+
+		expected := "standalone_test.go:42: \n    assumption: [ fails ]\n        should: BeTrue \n      expected: true\n        actual: false\n"
+		if out.String() != expected {
+			t.Errorf("wanted '%s' got '%s'", expected, out.String())
+		}
+	})
+
+	t.Run("skips decorators when locating failures", func(t *testing.T) {
+		var out bytes.Buffer
+		should := NewFuzz(NewStandalone(&out), 7)
+
+		should.BeTrue(false, "fails")
+
+		if !strings.HasPrefix(out.String(), "standalone_test.go:54: ") {
+			t.Errorf("wanted the location of the assertion got '%s'", out.String())
+		}
+	})
+
+	t.Run("does not fail without failures", func(t *testing.T) {
+		runner := NewStandalone(nil)
+		should := New(runner)
+
+		should.BeTrue(true, "passes")
+
+		if runner.Failed() || len(runner.Failures()) != 0 {
+			t.Errorf("runner was expected to not fail but it did: %v", runner.Failures())
+		}
+		if summary := runner.Summary(); summary != "all assertions passed" {
+			t.Errorf("wanted 'all assertions passed' got '%s'", summary)
+		}
+	})
+}
+
+func ExampleNewStandalone() {
+	runner := NewStandalone(ioutil.Discard)
+	should := New(runner)
+
+	should.BeEqual(42, 40+1, "the answer is 42")
+
+	fmt.Println(runner.Failed(), runner.Summary())
+	// Output: true 1 assertion failed
+}
@@ -0,0 +1,125 @@
+package should
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+const closestMatchLogFormat string = "\nassumption: [ %s ]\n    should: %s \n  expected: %v\n    actual: %v\n   closest: %v"
+
+// HavePrefix fails the test if actual does not start with expected.
+func (s *Should) HavePrefix(expected, actual string, assumption ...string) {
+	defer s.track("HavePrefix", assumption)()
+
+	if !strings.HasPrefix(actual, expected) {
+		s.t.Helper()
+		s.t.Log(fmt.Sprintf(valuesLogFormat, describe(s.assumed(assumption), "HavePrefix", "%[2]s has prefix %[1]s"), "HavePrefix",
+			escape(expected), escape(actual)))
+		s.t.Fail()
+	}
+}
+
+// HaveSuffix fails the test if actual does not end with expected.
+func (s *Should) HaveSuffix(expected, actual string, assumption ...string) {
+	defer s.track("HaveSuffix", assumption)()
+
+	if !strings.HasSuffix(actual, expected) {
+		s.t.Helper()
+		s.t.Log(fmt.Sprintf(valuesLogFormat, describe(s.assumed(assumption), "HaveSuffix", "%[2]s has suffix %[1]s"), "HaveSuffix",
+			escape(expected), escape(actual)))
+		s.t.Fail()
+	}
+}
+
+// ContainSubstring fails the test if expected is not within actual.
+// The failure points to the part of actual that came closest to expected.
+func (s *Should) ContainSubstring(expected, actual string, assumption ...string) {
+	defer s.track("ContainSubstring", assumption)()
+
+	if !strings.Contains(actual, expected) {
+		s.t.Helper()
+		s.t.Log(fmt.Sprintf(closestMatchLogFormat, describe(s.assumed(assumption), "ContainSubstring", "%[2]s contains %[1]s"), "ContainSubstring",
+			escape(expected), escape(actual), closestMatch(expected, actual)))
+		s.t.Fail()
+	}
+}
+
+// MatchRegexp fails the test if actual does not match the regular expression pattern.
+func (s *Should) MatchRegexp(pattern, actual string, assumption ...string) {
+	defer s.track("MatchRegexp", assumption)()
+
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		s.t.Helper()
+		s.t.Log(fmt.Sprintf(reasonLogFormat, describe(s.assumed(assumption), "MatchRegexp", "%[2]s matches %[1]s"), "MatchRegexp",
+			"invalid pattern: "+err.Error(), escape(pattern), escape(actual)))
+		s.t.Fail()
+		return
+	}
+
+	if !re.MatchString(actual) {
+		s.t.Helper()
+		s.t.Log(fmt.Sprintf(valuesLogFormat, describe(s.assumed(assumption), "MatchRegexp", "%[2]s matches %[1]s"), "MatchRegexp",
+			escape(pattern), escape(actual)))
+		s.t.Fail()
+	}
+}
+
+// BeEqualFold compares expected and actual ignoring case and fails the test if they differ.
+func (s *Should) BeEqualFold(expected, actual string, assumption ...string) {
+	defer s.track("BeEqualFold", assumption)()
+
+	if !strings.EqualFold(expected, actual) {
+		s.t.Helper()
+		s.t.Log(fmt.Sprintf(valuesLogFormat, describe(s.assumed(assumption), "BeEqualFold", "%[2]s equals %[1]s ignoring case"), "BeEqualFold",
+			escape(expected), escape(actual)))
+		s.t.Fail()
+	}
+}
+
+// BeEqualIgnoringWhitespace compares expected and actual and fails the test if they differ.
+// Leading and trailing whitespace is ignored and any other run of whitespace is treated as a single space.
+func (s *Should) BeEqualIgnoringWhitespace(expected, actual string, assumption ...string) {
+	defer s.track("BeEqualIgnoringWhitespace", assumption)()
+
+	if normaliseWhitespace(expected) != normaliseWhitespace(actual) {
+		s.t.Helper()
+		s.t.Log(fmt.Sprintf(valuesLogFormat, describe(s.assumed(assumption), "BeEqualIgnoringWhitespace", "%[2]s equals %[1]s ignoring whitespace"), "BeEqualIgnoringWhitespace",
+			escape(expected), escape(actual)))
+		s.t.Fail()
+	}
+}
+
+func normaliseWhitespace(value string) string {
+	return strings.Join(strings.Fields(value), " ")
+}
+
+// closestMatch returns the part of actual that shares the longest prefix with
+// expected, together with its offset, or "none" when not even the first
+// character of expected is present.
+func closestMatch(expected, actual string) string {
+	bestOffset, bestLength := -1, 0
+	for offset := 0; offset < len(actual); offset++ {
+		length := 0
+		for length < len(expected) && offset+length < len(actual) &&
+			expected[length] == actual[offset+length] {
+			length++
+		}
+
+		if length > bestLength {
+			bestOffset, bestLength = offset, length
+		}
+	}
+
+	if bestOffset < 0 {
+		return "none"
+	}
+
+	end := bestOffset + len(expected)
+	if end > len(actual) {
+		end = len(actual)
+	}
+
+	return fmt.Sprintf("%v (offset %d)", escape(actual[bestOffset:end]), bestOffset)
+}
@@ -0,0 +1,289 @@
+package should
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestHavePrefix(t *testing.T) {
+	t.Run("scenarios that must fail tests", func(t *testing.T) {
+		assertThat := func(assumption string, expected, actual string) {
+			stub := testingStub{}
+			should := New(&stub)
+			expectedLogMessage := fmt.Sprintf("\nassumption: [ %s ]\n    should: %s \n  expected: %v\n    actual: %v",
+				assumption, "HavePrefix", escape(expected), escape(actual))
+
+			should.HavePrefix(expected, actual, assumption)
+
+			if !stub.hasFailed {
+				t.Error("test was expected to fail but did not")
+			}
+			if !stub.WasHelperCalled() {
+				t.Error("Helper() call was expected but did not happen")
+			}
+			if expectedLogMessage != stub.logMessage {
+				t.Errorf("wanted '%s' got '%s'", expectedLogMessage, stub.logMessage)
+			}
+		}
+
+		assertThat("should fail for missing prefix", "<html>", "<body>\n</body>")
+	})
+
+	t.Run("scenarios that must not fail tests", func(t *testing.T) {
+		assertThat := func(assumption string, expected, actual string) {
+			stub := testingStub{}
+			should := New(&stub)
+
+			should.HavePrefix(expected, actual, assumption)
+
+			if stub.hasFailed {
+				t.Error("test was expected to not fail but it did")
+			}
+			if stub.WasHelperCalled() {
+				t.Error("Helper() call was not expected")
+			}
+			if stub.logMessage != "" {
+				t.Errorf("wanted '%s' got '%s'", "", stub.logMessage)
+			}
+		}
+
+		assertThat("should not fail for matching prefix", "<html>", "<html><body></body></html>")
+		assertThat("should not fail for empty prefix", "", "abc")
+	})
+}
+
+func TestHaveSuffix(t *testing.T) {
+	t.Run("scenarios that must fail tests", func(t *testing.T) {
+		assertThat := func(assumption string, expected, actual string) {
+			stub := testingStub{}
+			should := New(&stub)
+			expectedLogMessage := fmt.Sprintf("\nassumption: [ %s ]\n    should: %s \n  expected: %v\n    actual: %v",
+				assumption, "HaveSuffix", escape(expected), escape(actual))
+
+			should.HaveSuffix(expected, actual, assumption)
+
+			if !stub.hasFailed {
+				t.Error("test was expected to fail but did not")
+			}
+			if !stub.WasHelperCalled() {
+				t.Error("Helper() call was expected but did not happen")
+			}
+			if expectedLogMessage != stub.logMessage {
+				t.Errorf("wanted '%s' got '%s'", expectedLogMessage, stub.logMessage)
+			}
+		}
+
+		assertThat("should fail for missing suffix", "</html>", "<html>\t</body>")
+	})
+
+	t.Run("scenarios that must not fail tests", func(t *testing.T) {
+		assertThat := func(assumption string, expected, actual string) {
+			stub := testingStub{}
+			should := New(&stub)
+
+			should.HaveSuffix(expected, actual, assumption)
+
+			if stub.hasFailed {
+				t.Error("test was expected to not fail but it did")
+			}
+			if stub.WasHelperCalled() {
+				t.Error("Helper() call was not expected")
+			}
+			if stub.logMessage != "" {
+				t.Errorf("wanted '%s' got '%s'", "", stub.logMessage)
+			}
+		}
+
+		assertThat("should not fail for matching suffix", "</html>", "<html></html>")
+	})
+}
+
+func TestBeEqualFold(t *testing.T) {
+	t.Run("scenarios that must fail tests", func(t *testing.T) {
+		assertThat := func(assumption string, expected, actual string) {
+			stub := testingStub{}
+			should := New(&stub)
+			expectedLogMessage := fmt.Sprintf("\nassumption: [ %s ]\n    should: %s \n  expected: %v\n    actual: %v",
+				assumption, "BeEqualFold", escape(expected), escape(actual))
+
+			should.BeEqualFold(expected, actual, assumption)
+
+			if !stub.hasFailed {
+				t.Error("test was expected to fail but did not")
+			}
+			if !stub.WasHelperCalled() {
+				t.Error("Helper() call was expected but did not happen")
+			}
+			if expectedLogMessage != stub.logMessage {
+				t.Errorf("wanted '%s' got '%s'", expectedLogMessage, stub.logMessage)
+			}
+		}
+
+		assertThat("should fail for different strings", "Hello", "Hallo")
+	})
+
+	t.Run("scenarios that must not fail tests", func(t *testing.T) {
+		assertThat := func(assumption string, expected, actual string) {
+			stub := testingStub{}
+			should := New(&stub)
+
+			should.BeEqualFold(expected, actual, assumption)
+
+			if stub.hasFailed {
+				t.Error("test was expected to not fail but it did")
+			}
+			if stub.WasHelperCalled() {
+				t.Error("Helper() call was not expected")
+			}
+			if stub.logMessage != "" {
+				t.Errorf("wanted '%s' got '%s'", "", stub.logMessage)
+			}
+		}
+
+		assertThat("should not fail for strings differing in case", "Hello", "hELLO")
+	})
+}
+
+func TestBeEqualIgnoringWhitespace(t *testing.T) {
+	t.Run("scenarios that must fail tests", func(t *testing.T) {
+		assertThat := func(assumption string, expected, actual string) {
+			stub := testingStub{}
+			should := New(&stub)
+			expectedLogMessage := fmt.Sprintf("\nassumption: [ %s ]\n    should: %s \n  expected: %v\n    actual: %v",
+				assumption, "BeEqualIgnoringWhitespace", escape(expected), escape(actual))
+
+			should.BeEqualIgnoringWhitespace(expected, actual, assumption)
+
+			if !stub.hasFailed {
+				t.Error("test was expected to fail but did not")
+			}
+			if !stub.WasHelperCalled() {
+				t.Error("Helper() call was expected but did not happen")
+			}
+			if expectedLogMessage != stub.logMessage {
+				t.Errorf("wanted '%s' got '%s'", expectedLogMessage, stub.logMessage)
+			}
+		}
+
+		assertThat("should fail for different words", "a b\tc", "a b\nd")
+	})
+
+	t.Run("scenarios that must not fail tests", func(t *testing.T) {
+		assertThat := func(assumption string, expected, actual string) {
+			stub := testingStub{}
+			should := New(&stub)
+
+			should.BeEqualIgnoringWhitespace(expected, actual, assumption)
+
+			if stub.hasFailed {
+				t.Error("test was expected to not fail but it did")
+			}
+			if stub.WasHelperCalled() {
+				t.Error("Helper() call was not expected")
+			}
+			if stub.logMessage != "" {
+				t.Errorf("wanted '%s' got '%s'", "", stub.logMessage)
+			}
+		}
+
+		assertThat("should not fail for strings differing in whitespace", "<a>\n\t<b/>\n</a>", " <a> <b/>  </a>")
+	})
+}
+
+func TestContainSubstring(t *testing.T) {
+	t.Run("scenarios that must fail tests", func(t *testing.T) {
+		assertThat := func(assumption string, expected, actual string, closest string) {
+			stub := testingStub{}
+			should := New(&stub)
+			expectedLogMessage := fmt.Sprintf("\nassumption: [ %s ]\n    should: %s \n  expected: %v\n    actual: %v\n   closest: %v",
+				assumption, "ContainSubstring", escape(expected), escape(actual), closest)
+
+			should.ContainSubstring(expected, actual, assumption)
+
+			if !stub.hasFailed {
+				t.Error("test was expected to fail but did not")
+			}
+			if !stub.WasHelperCalled() {
+				t.Error("Helper() call was expected but did not happen")
+			}
+			if expectedLogMessage != stub.logMessage {
+				t.Errorf("wanted '%s' got '%s'", expectedLogMessage, stub.logMessage)
+			}
+		}
+
+		assertThat("should point to the closest partial match",
+			"level=error msg=timeout", "level=info msg=ok\nlevel=error msg=retry", "level=error msg=retry (offset 18)")
+		assertThat("should truncate the closest match at the end of actual",
+			"world!", "hello wor", "wor (offset 6)")
+		assertThat("should report no match when no character is present",
+			"xyz", "abc", "none")
+	})
+
+	t.Run("scenarios that must not fail tests", func(t *testing.T) {
+		assertThat := func(assumption string, expected, actual string) {
+			stub := testingStub{}
+			should := New(&stub)
+
+			should.ContainSubstring(expected, actual, assumption)
+
+			if stub.hasFailed {
+				t.Error("test was expected to not fail but it did")
+			}
+			if stub.WasHelperCalled() {
+				t.Error("Helper() call was not expected")
+			}
+			if stub.logMessage != "" {
+				t.Errorf("wanted '%s' got '%s'", "", stub.logMessage)
+			}
+		}
+
+		assertThat("should not fail for contained substring", "msg=ok", "level=info msg=ok")
+	})
+}
+
+func TestMatchRegexp(t *testing.T) {
+	t.Run("scenarios that must fail tests", func(t *testing.T) {
+		assertThat := func(assumption string, pattern, actual string, expectedLogMessage string) {
+			stub := testingStub{}
+			should := New(&stub)
+
+			should.MatchRegexp(pattern, actual, assumption)
+
+			if !stub.hasFailed {
+				t.Error("test was expected to fail but did not")
+			}
+			if !stub.WasHelperCalled() {
+				t.Error("Helper() call was expected but did not happen")
+			}
+			if expectedLogMessage != stub.logMessage {
+				t.Errorf("wanted '%s' got '%s'", expectedLogMessage, stub.logMessage)
+			}
+		}
+
+		assertThat("should fail for non matching value", `^\d+$`, "12a",
+			"\nassumption: [ should fail for non matching value ]\n    should: MatchRegexp \n  expected: ^\\d+$\n    actual: 12a")
+		assertThat("should fail for invalid pattern", `(`, "abc",
+			"\nassumption: [ should fail for invalid pattern ]\n    should: MatchRegexp \n    reason: invalid pattern: error parsing regexp: missing closing ): `(`\n  expected: (\n    actual: abc")
+	})
+
+	t.Run("scenarios that must not fail tests", func(t *testing.T) {
+		assertThat := func(assumption string, pattern, actual string) {
+			stub := testingStub{}
+			should := New(&stub)
+
+			should.MatchRegexp(pattern, actual, assumption)
+
+			if stub.hasFailed {
+				t.Error("test was expected to not fail but it did")
+			}
+			if stub.WasHelperCalled() {
+				t.Error("Helper() call was not expected")
+			}
+			if stub.logMessage != "" {
+				t.Errorf("wanted '%s' got '%s'", "", stub.logMessage)
+			}
+		}
+
+		assertThat("should not fail for matching value", `^\d+$`, "123")
+	})
+}
@@ -0,0 +1,210 @@
+package should
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"runtime"
+	"strings"
+)
+
+// TestingT is the contract Should needs from a test runner. It is implemented by
+// *testing.T, *testing.B and *testing.F, so Should works in tests, benchmarks and
+// fuzz targets alike. Other runners can be plugged in through FromErrorf and FromFailHandler,
+// and code running outside of go test can use NewStandalone.
+//
+// Runners may also implement FailNowT, NameT, CleanupT and TempDirT, which are detected at runtime,
+// and runners decorating another one implement WrapperT so the capabilities of theirs are detected too.
+type TestingT interface {
+	Helper()
+	Log(args ...interface{})
+	Fail()
+}
+
+// FailNowT is implemented by runners that can stop a test as it fails.
+type FailNowT interface {
+	FailNow()
+}
+
+// NameT is implemented by runners that know the name of the running test.
+type NameT interface {
+	Name() string
+}
+
+// CleanupT is implemented by runners that can run functions once a test completes.
+type CleanupT interface {
+	Cleanup(fn func())
+}
+
+// TempDirT is implemented by runners that provide a temporary directory per test.
+type TempDirT interface {
+	TempDir() string
+}
+
+// WrapperT is implemented by runners that decorate another runner, such as those adding
+// context to every message logged, so the capabilities of the runner they wrap are still detected.
+type WrapperT interface {
+	Unwrap() TestingT
+}
+
+// wrappingT is implemented by TestingT decorators in this package, for the same purpose as WrapperT.
+type wrappingT interface {
+	unwrap() TestingT
+}
+
+// capability returns the first of t and the runners it wraps that satisfies check.
+func capability(t TestingT, check func(TestingT) bool) (TestingT, bool) {
+	for t != nil {
+		if check(t) {
+			return t, true
+		}
+		t = unwrapT(t)
+	}
+	return nil, false
+}
+
+// unwrapT returns the runner t wraps, or nil if it does not wrap one.
+func unwrapT(t TestingT) TestingT {
+	switch w := t.(type) {
+	case wrappingT:
+		return w.unwrap()
+	case WrapperT:
+		return w.Unwrap()
+	}
+	return nil
+}
+
+// bufferingT is implemented by runners and wrappers that keep logged messages until the next Fail.
+type bufferingT interface {
+	discardPending()
+}
+
+// note logs message without failing the test, and keeps it out of the messages that
+// runners and wrappers buffer for the next failure. Runners that only report messages
+// when the test fails, such as those adapted by FromErrorf, drop it.
+func (s *Should) note(message string) {
+	s.t.Helper()
+	s.t.Log(message)
+
+	for t := s.t; t != nil; t = unwrapT(t) {
+		if b, ok := t.(bufferingT); ok {
+			b.discardPending()
+		}
+	}
+}
+
+// FailNow marks the test as failed and stops it, when the runner implements FailNowT.
+// Otherwise it only marks the test as failed.
+func (s *Should) FailNow() {
+	if t, ok := capability(s.t, func(t TestingT) bool { _, ok := t.(FailNowT); return ok }); ok {
+		t.(FailNowT).FailNow()
+		return
+	}
+	s.t.Fail()
+}
+
+// Name returns the name of the running test, or an empty string when the runner does not implement NameT.
+func (s *Should) Name() string {
+	if t, ok := capability(s.t, func(t TestingT) bool { _, ok := t.(NameT); return ok }); ok {
+		return t.(NameT).Name()
+	}
+	return ""
+}
+
+// Cleanup registers fn to run once the test completes, and reports whether the runner implements CleanupT.
+// When it does not, fn is not registered and will not run.
+func (s *Should) Cleanup(fn func()) bool {
+	if t, ok := capability(s.t, func(t TestingT) bool { _, ok := t.(CleanupT); return ok }); ok {
+		t.(CleanupT).Cleanup(fn)
+		return true
+	}
+	return false
+}
+
+// TempDir returns a temporary directory for the test. When the runner does not implement TempDirT,
+// a new directory is created, and removed once the test completes if the runner implements CleanupT.
+// It fails the test and returns an empty string if the directory cannot be created.
+func (s *Should) TempDir() string {
+	if t, ok := capability(s.t, func(t TestingT) bool { _, ok := t.(TempDirT); return ok }); ok {
+		return t.(TempDirT).TempDir()
+	}
+
+	dir, err := ioutil.TempDir("", "should")
+	if err != nil {
+		s.t.Helper()
+		s.t.Log(fmt.Sprintf(reasonLogFormat, "temporary directory is created", "TempDir", err.Error(), "directory", nil))
+		s.t.Fail()
+		return ""
+	}
+
+	s.Cleanup(func() { os.RemoveAll(dir) })
+	return dir
+}
+
+// FromErrorf adapts runners that report failures through Errorf, such as those accepted by
+// testify or gomega, to TestingT. Messages logged are reported together when the test fails.
+func FromErrorf(t interface {
+	Errorf(format string, args ...interface{})
+}) TestingT {
+	return &errorfT{errorf: t.Errorf, helper: helperOf(t)}
+}
+
+// FromFailHandler adapts runners that report failures through a handler, such as ginkgo.Fail, to TestingT.
+// Messages logged are passed to fail when the test fails, skipping the frames of this module.
+func FromFailHandler(fail func(message string, callerSkip ...int)) TestingT {
+	return &errorfT{errorf: func(format string, args ...interface{}) {
+		fail(fmt.Sprintf(format, args...), callerSkip())
+	}, helper: func() {}}
+}
+
+// modulePath prefixes the functions of this module, whichever wrappers sit between an assertion and its runner.
+const modulePath string = "github.com/pjbgf/go-test/"
+
+// callerSkip returns how many frames above its caller the assertion was written, i.e. the
+// first frame outside of this module. Frames from test files are always considered callers.
+func callerSkip() int {
+	pc := make([]uintptr, 64)
+	n := runtime.Callers(2, pc)
+	frames := runtime.CallersFrames(pc[:n])
+
+	for skip := 0; ; skip++ {
+		frame, more := frames.Next()
+		if !strings.HasPrefix(frame.Function, modulePath) ||
+			strings.HasSuffix(frame.File, "_test.go") || !more {
+			return skip
+		}
+	}
+}
+
+// errorfT buffers logged messages and reports them through errorf on Fail.
+type errorfT struct {
+	errorf   func(format string, args ...interface{})
+	helper   func()
+	messages []string
+}
+
+func (t *errorfT) Helper() {
+	t.helper()
+}
+
+func (t *errorfT) Log(args ...interface{}) {
+	t.messages = append(t.messages, fmt.Sprint(args...))
+}
+
+func (t *errorfT) Fail() {
+	t.helper()
+	t.errorf("%s", strings.Join(t.messages, "\n"))
+	t.messages = nil
+}
+
+func (t *errorfT) discardPending() {
+	t.messages = nil
+}
+
+// helperOf returns the Helper method of t, or a no-op when t has none.
+func helperOf(t interface{}) func() {
+	if h, ok := t.(interface{ Helper() }); ok {
+		return h.Helper
+	}
+	return func() {}
+}
@@ -0,0 +1,204 @@
+package should
+
+import (
+	"os"
+	"runtime"
+	"testing"
+)
+
+var (
+	_ TestingT = (*testing.T)(nil)
+	_ TestingT = (*testing.B)(nil)
+	_ TestingT = (*testing.F)(nil)
+)
+
+type capableStub struct {
+	testingStub
+	failedNow bool
+	cleanups  []func()
+	tempDir   string
+}
+
+func (t *capableStub) FailNow() {
+	t.failedNow = true
+}
+
+func (t *capableStub) Name() string {
+	return "TestCapable"
+}
+
+func (t *capableStub) Cleanup(fn func()) {
+	t.cleanups = append(t.cleanups, fn)
+}
+
+func (t *capableStub) TempDir() string {
+	return t.tempDir
+}
+
+type cleanupStub struct {
+	testingStub
+	cleanups []func()
+}
+
+func (t *cleanupStub) Cleanup(fn func()) {
+	t.cleanups = append(t.cleanups, fn)
+}
+
+// prefixingT is a decorator from outside of Should, exposing the runner it wraps through WrapperT.
+type prefixingT struct {
+	TestingT
+}
+
+func (t *prefixingT) Log(args ...interface{}) {
+	t.TestingT.Log(append([]interface{}{"prefix:"}, args...)...)
+}
+
+func (t *prefixingT) Unwrap() TestingT {
+	return t.TestingT
+}
+
+type errorfStub struct {
+	format string
+	args   []interface{}
+}
+
+func (t *errorfStub) Errorf(format string, args ...interface{}) {
+	t.format, t.args = format, args
+}
+
+func TestCapabilities(t *testing.T) {
+	t.Run("uses the capabilities of the runner", func(t *testing.T) {
+		stub := capableStub{tempDir: "/tmp/capable"}
+		should := New(&stub)
+
+		should.FailNow()
+		registered := should.Cleanup(func() {})
+
+		if !stub.failedNow || stub.hasFailed {
+			t.Error("FailNow() call was expected to be forwarded")
+		}
+		if name := should.Name(); name != "TestCapable" {
+			t.Errorf("wanted 'TestCapable' got '%s'", name)
+		}
+		if !registered || len(stub.cleanups) != 1 {
+			t.Error("Cleanup() call was expected to be forwarded")
+		}
+		if dir := should.TempDir(); dir != "/tmp/capable" {
+			t.Errorf("wanted '/tmp/capable' got '%s'", dir)
+		}
+	})
+
+	t.Run("detects the capabilities of wrapped runners", func(t *testing.T) {
+		stub := capableStub{}
+		should := NewFuzz(&stub, 1)
+
+		should.FailNow()
+
+		if !stub.failedNow {
+			t.Error("FailNow() call was expected to be forwarded")
+		}
+		if name := should.Name(); name != "TestCapable" {
+			t.Errorf("wanted 'TestCapable' got '%s'", name)
+		}
+	})
+
+	t.Run("detects the capabilities of runners wrapped through WrapperT", func(t *testing.T) {
+		stub := capableStub{}
+		should := New(&prefixingT{&stub})
+
+		should.FailNow()
+
+		if !stub.failedNow {
+			t.Error("FailNow() call was expected to be forwarded")
+		}
+		if name := should.Name(); name != "TestCapable" {
+			t.Errorf("wanted 'TestCapable' got '%s'", name)
+		}
+	})
+
+	t.Run("falls back when the runner lacks capabilities", func(t *testing.T) {
+		stub := testingStub{}
+		should := New(&stub)
+
+		should.FailNow()
+		registered := should.Cleanup(func() {})
+		dir := should.TempDir()
+		defer os.RemoveAll(dir)
+
+		if !stub.hasFailed {
+			t.Error("FailNow() was expected to fail the test")
+		}
+		if name := should.Name(); name != "" {
+			t.Errorf("wanted '' got '%s'", name)
+		}
+		if registered {
+			t.Error("Cleanup() was not expected to register")
+		}
+		if info, err := os.Stat(dir); err != nil || !info.IsDir() {
+			t.Errorf("wanted a temporary directory got '%s': %v", dir, err)
+		}
+	})
+
+	t.Run("removes fallback directories on cleanup", func(t *testing.T) {
+		stub := cleanupStub{}
+		should := New(&stub)
+
+		dir := should.TempDir()
+		for _, fn := range stub.cleanups {
+			fn()
+		}
+
+		if dir == "" {
+			t.Fatal("wanted a temporary directory got ''")
+		}
+		if _, err := os.Stat(dir); !os.IsNotExist(err) {
+			t.Errorf("wanted '%s' to be removed: %v", dir, err)
+		}
+	})
+}
+
+func TestFromErrorf(t *testing.T) {
+	stub := errorfStub{}
+	should := New(FromErrorf(&stub))
+
+	should.BeTrue(false, "should report through Errorf")
+
+	expected := "\nassumption: [ should report through Errorf ]\n    should: BeTrue \n  expected: true\n    actual: false"
+	if stub.format != "%s" || len(stub.args) != 1 || stub.args[0] != expected {
+		t.Errorf("wanted '%s' got '%v'", expected, stub.args)
+	}
+}
+
+func TestFromFailHandler(t *testing.T) {
+	var message, file string
+	var line int
+	handler := FromFailHandler(func(m string, callerSkip ...int) {
+		message = m
+		// as ginkgo.Fail does, skip the frames above the caller of the handler
+		_, file, line, _ = runtime.Caller(callerSkip[0] + 1)
+	})
+
+	should := New(handler)
+	should.BeTrue(true, "should not report passing assertions")
+	if message != "" {
+		t.Errorf("wanted '' got '%s'", message)
+	}
+
+	_, wantFile, wantLine, _ := runtime.Caller(0)
+	should.BeTrue(false, "should report through the handler")
+
+	expected := "\nassumption: [ should report through the handler ]\n    should: BeTrue \n  expected: true\n    actual: false"
+	if message != expected {
+		t.Errorf("wanted '%s' got '%s'", expected, message)
+	}
+	if file != wantFile || line != wantLine+1 {
+		t.Errorf("wanted failure reported at %s:%d got %s:%d", wantFile, wantLine+1, file, line)
+	}
+
+	_, wantFile, wantLine, _ = runtime.Caller(0)
+	New(handler, WithJUnit(NewJUnitReporter())).BeTrue(false, "should report through the handler under JUnit")
+
+	if file != wantFile || line != wantLine+1 {
+		t.Errorf("wanted failure under JUnit reported at %s:%d got %s:%d", wantFile, wantLine+1, file, line)
+	}
+}
@@ -0,0 +1,77 @@
+package should
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	timeDifferenceLogFormat string = "\nassumption: [ %s ]\n    should: %s \n  expected: %v\n    actual: %v\ndifference: %v"
+	toleranceLogFormat      string = "\nassumption: [ %s ]\n    should: %s \n  expected: %v\n    actual: %v\ndifference: %v\n tolerance: %v"
+)
+
+// BeSameInstant fails the test if expected and actual do not represent the same instant.
+// Unlike BeEqual, locations and monotonic clock readings are ignored.
+func (s *Should) BeSameInstant(expected, actual time.Time, assumption ...string) {
+	defer s.track("BeSameInstant", assumption)()
+
+	if !expected.Equal(actual) {
+		s.t.Helper()
+		s.t.Log(fmt.Sprintf(timeDifferenceLogFormat, describe(s.assumed(assumption), "BeSameInstant", "%[2]s is the same instant as %[1]s"), "BeSameInstant",
+			formatTime(expected), formatTime(actual), actual.Sub(expected)))
+		s.t.Fail()
+	}
+}
+
+// BeWithinDuration fails the test if actual is further than tolerance from expected, in either direction.
+func (s *Should) BeWithinDuration(expected, actual time.Time, tolerance time.Duration, assumption ...string) {
+	defer s.track("BeWithinDuration", assumption)()
+
+	difference := actual.Sub(expected)
+	if difference < -tolerance || difference > tolerance {
+		s.t.Helper()
+		s.t.Log(fmt.Sprintf(toleranceLogFormat, describe(s.assumed(assumption), "BeWithinDuration", "%[2]s is within %[3]s of %[1]s"), "BeWithinDuration",
+			formatTime(expected), formatTime(actual), difference, tolerance))
+		s.t.Fail()
+	}
+}
+
+// BeBefore fails the test if actual is not before expected.
+func (s *Should) BeBefore(expected, actual time.Time, assumption ...string) {
+	defer s.track("BeBefore", assumption)()
+
+	if !actual.Before(expected) {
+		s.t.Helper()
+		s.t.Log(fmt.Sprintf(timeDifferenceLogFormat, describe(s.assumed(assumption), "BeBefore", "%[2]s is before %[1]s"), "BeBefore",
+			"< "+formatTime(expected), formatTime(actual), actual.Sub(expected)))
+		s.t.Fail()
+	}
+}
+
+// BeAfter fails the test if actual is not after expected.
+func (s *Should) BeAfter(expected, actual time.Time, assumption ...string) {
+	defer s.track("BeAfter", assumption)()
+
+	if !actual.After(expected) {
+		s.t.Helper()
+		s.t.Log(fmt.Sprintf(timeDifferenceLogFormat, describe(s.assumed(assumption), "BeAfter", "%[2]s is after %[1]s"), "BeAfter",
+			"> "+formatTime(expected), formatTime(actual), actual.Sub(expected)))
+		s.t.Fail()
+	}
+}
+
+// BeZeroTime fails the test if value is not the zero time.
+func (s *Should) BeZeroTime(value time.Time, assumption ...string) {
+	defer s.track("BeZeroTime", assumption)()
+
+	if !value.IsZero() {
+		s.t.Helper()
+		s.t.Log(fmt.Sprintf(valuesLogFormat, describe(s.assumed(assumption), "BeZeroTime", "%[1]s is the zero time"), "BeZeroTime",
+			formatTime(time.Time{}), formatTime(value)))
+		s.t.Fail()
+	}
+}
+
+func formatTime(value time.Time) string {
+	return value.Format(time.RFC3339Nano) + " (" + value.Location().String() + ")"
+}
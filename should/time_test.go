@@ -0,0 +1,73 @@
+package should
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTime(t *testing.T) {
+	utc := time.Date(2020, 1, 2, 3, 4, 5, 600, time.UTC)
+	plusTwo := utc.In(time.FixedZone("UTC+2", 2*60*60))
+
+	t.Run("scenarios that must fail tests", func(t *testing.T) {
+		assertThat := func(assumption string, check func(*Should), expectedLogMessage string) {
+			stub := testingStub{}
+			should := New(&stub)
+
+			check(should)
+
+			if !stub.hasFailed {
+				t.Error("test was expected to fail but did not")
+			}
+			if !stub.WasHelperCalled() {
+				t.Error("Helper() call was expected but did not happen")
+			}
+			if expectedLogMessage != stub.logMessage {
+				t.Errorf("wanted '%s' got '%s'", expectedLogMessage, stub.logMessage)
+			}
+		}
+
+		assertThat("should fail for different instants",
+			func(s *Should) { s.BeSameInstant(utc, plusTwo.Add(time.Second), "same instant") },
+			"\nassumption: [ same instant ]\n    should: BeSameInstant \n  expected: 2020-01-02T03:04:05.0000006Z (UTC)\n    actual: 2020-01-02T05:04:06.0000006+02:00 (UTC+2)\ndifference: 1s")
+		assertThat("should fail for times beyond tolerance",
+			func(s *Should) { s.BeWithinDuration(utc, utc.Add(-3*time.Minute), time.Minute, "close enough") },
+			"\nassumption: [ close enough ]\n    should: BeWithinDuration \n  expected: 2020-01-02T03:04:05.0000006Z (UTC)\n    actual: 2020-01-02T03:01:05.0000006Z (UTC)\ndifference: -3m0s\n tolerance: 1m0s")
+		assertThat("should fail for times not before",
+			func(s *Should) { s.BeBefore(utc, plusTwo, "is before") },
+			"\nassumption: [ is before ]\n    should: BeBefore \n  expected: < 2020-01-02T03:04:05.0000006Z (UTC)\n    actual: 2020-01-02T05:04:05.0000006+02:00 (UTC+2)\ndifference: 0s")
+		assertThat("should fail for times not after",
+			func(s *Should) { s.BeAfter(utc, utc.Add(-time.Hour), "is after") },
+			"\nassumption: [ is after ]\n    should: BeAfter \n  expected: > 2020-01-02T03:04:05.0000006Z (UTC)\n    actual: 2020-01-02T02:04:05.0000006Z (UTC)\ndifference: -1h0m0s")
+		assertThat("should fail for non zero time",
+			func(s *Should) { s.BeZeroTime(utc, "is zero") },
+			"\nassumption: [ is zero ]\n    should: BeZeroTime \n  expected: 0001-01-01T00:00:00Z (UTC)\n    actual: 2020-01-02T03:04:05.0000006Z (UTC)")
+	})
+
+	t.Run("scenarios that must not fail tests", func(t *testing.T) {
+		assertThat := func(assumption string, check func(*Should)) {
+			stub := testingStub{}
+			should := New(&stub)
+
+			check(should)
+
+			if stub.hasFailed {
+				t.Error("test was expected to not fail but it did")
+			}
+			if stub.WasHelperCalled() {
+				t.Error("Helper() call was not expected")
+			}
+			if stub.logMessage != "" {
+				t.Errorf("wanted '%s' got '%s'", "", stub.logMessage)
+			}
+		}
+
+		now := time.Now()
+		assertThat("should not fail for same instant across zones", func(s *Should) { s.BeSameInstant(utc, plusTwo) })
+		assertThat("should not fail for monotonic readings", func(s *Should) { s.BeSameInstant(now.Round(0), now) })
+		assertThat("should not fail within tolerance", func(s *Should) { s.BeWithinDuration(utc, utc.Add(-time.Second), time.Second) })
+		assertThat("should not fail for earlier time", func(s *Should) { s.BeBefore(utc, utc.Add(-1)) })
+		assertThat("should not fail for later time", func(s *Should) { s.BeAfter(utc, plusTwo.Add(1)) })
+		assertThat("should not fail for zero time", func(s *Should) { s.BeZeroTime(time.Time{}) })
+	})
+}
@@ -0,0 +1,219 @@
+package should
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// xmlNode is either an element or, when name is empty, a text node.
+type xmlNode struct {
+	name     xml.Name
+	attrs    map[string]string
+	children []*xmlNode
+	text     string
+}
+
+// BeEqualXML compares the XML documents in expected and actual and fails the test if they are not semantically equal.
+// Attribute order, namespace prefixes, comments and whitespace around text are ignored.
+// Both values can be a string, []byte or io.Reader.
+func (s *Should) BeEqualXML(expected, actual interface{}, assumption ...string) {
+	defer s.track("BeEqualXML", assumption)()
+
+	expectedText, expectedDoc, expectedErr := decodeXML(expected)
+	actualText, actualDoc, actualErr := decodeXML(actual)
+
+	if expectedErr != nil || actualErr != nil {
+		s.t.Helper()
+		s.t.Log(fmt.Sprintf(reasonLogFormat, describe(s.assumed(assumption), "BeEqualXML", "%[2]s equals XML %[1]s"), "BeEqualXML",
+			invalidDocumentReason("XML", expectedErr, actualErr), escape(expectedText), escape(actualText)))
+		s.t.Fail()
+		return
+	}
+
+	differences := diffXML("/"+nodeName(expectedDoc), expectedDoc, actualDoc)
+	if len(differences) > 0 {
+		s.t.Helper()
+		s.t.Log(fmt.Sprintf(differencesLogFormat, describe(s.assumed(assumption), "BeEqualXML", "%[2]s equals XML %[1]s"), "BeEqualXML",
+			"documents differ", escape(expectedText), escape(actualText), strings.Join(differences, differencesIndent)))
+		s.t.Fail()
+	}
+}
+
+// decodeXML reads value and builds the tree of its root element from the
+// token stream, dropping whitespace-only text, comments and directives.
+func decodeXML(value interface{}) (text string, root *xmlNode, err error) {
+	data, err := readDocument(value)
+	if err != nil {
+		return string(data), nil, err
+	}
+
+	var stack []*xmlNode
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return string(data), nil, err
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			if root != nil && len(stack) == 0 {
+				return string(data), nil, errors.New("multiple root elements")
+			}
+
+			node := &xmlNode{name: t.Name, attrs: make(map[string]string)}
+			for _, attr := range t.Attr {
+				if attr.Name.Space == "xmlns" || (attr.Name.Space == "" && attr.Name.Local == "xmlns") {
+					continue
+				}
+				node.attrs[qualifiedName(attr.Name)] = attr.Value
+			}
+
+			if len(stack) > 0 {
+				parent := stack[len(stack)-1]
+				parent.children = append(parent.children, node)
+			} else {
+				root = node
+			}
+			stack = append(stack, node)
+
+		case xml.EndElement:
+			stack = stack[:len(stack)-1]
+
+		case xml.CharData:
+			content := strings.TrimSpace(string(t))
+			if content == "" || len(stack) == 0 {
+				continue
+			}
+
+			parent := stack[len(stack)-1]
+			if last := len(parent.children) - 1; last >= 0 && parent.children[last].name.Local == "" {
+				parent.children[last].text += content
+				continue
+			}
+			parent.children = append(parent.children, &xmlNode{text: content})
+		}
+	}
+
+	if root == nil {
+		return string(data), nil, errors.New("no root element")
+	}
+
+	return string(data), root, nil
+}
+
+// diffXML compares two nodes found at path and returns one entry per difference,
+// each identified by the path of the element or attribute where it was found.
+func diffXML(path string, expected, actual *xmlNode) []string {
+	if expected.name != actual.name {
+		return []string{fmt.Sprintf("%s: expected %s, actual %s", path, nodeLabel(expected), nodeLabel(actual))}
+	}
+
+	if expected.name.Local == "" {
+		if expected.text != actual.text {
+			return []string{fmt.Sprintf("%s: expected %s, actual %s", path, strconv.Quote(expected.text), strconv.Quote(actual.text))}
+		}
+		return nil
+	}
+
+	var differences []string
+	for _, key := range sortedAttrs(expected.attrs, actual.attrs) {
+		attrPath := path + "/@" + key
+		expectedValue, inExpected := expected.attrs[key]
+		actualValue, inActual := actual.attrs[key]
+
+		switch {
+		case !inActual:
+			differences = append(differences, attrPath+": missing")
+		case !inExpected:
+			differences = append(differences, attrPath+": unexpected")
+		case expectedValue != actualValue:
+			differences = append(differences, fmt.Sprintf("%s: expected %s, actual %s", attrPath, strconv.Quote(expectedValue), strconv.Quote(actualValue)))
+		}
+	}
+
+	expectedPaths := childPaths(expected)
+	actualPaths := childPaths(actual)
+	for i := 0; i < len(expected.children) || i < len(actual.children); i++ {
+		switch {
+		case i >= len(actual.children):
+			differences = append(differences, path+"/"+expectedPaths[i]+": missing")
+		case i >= len(expected.children):
+			differences = append(differences, path+"/"+actualPaths[i]+": unexpected")
+		default:
+			differences = append(differences, diffXML(path+"/"+expectedPaths[i], expected.children[i], actual.children[i])...)
+		}
+	}
+
+	return differences
+}
+
+// childPaths names each child of node, adding a 1-based position to
+// elements that share their name with a sibling.
+func childPaths(node *xmlNode) []string {
+	counts := make(map[xml.Name]int)
+	for _, child := range node.children {
+		counts[child.name]++
+	}
+
+	seen := make(map[xml.Name]int)
+	paths := make([]string, len(node.children))
+	for i, child := range node.children {
+		seen[child.name]++
+		paths[i] = nodeName(child)
+		if counts[child.name] > 1 && child.name.Local != "" {
+			paths[i] += "[" + strconv.Itoa(seen[child.name]) + "]"
+		}
+	}
+
+	return paths
+}
+
+func nodeName(node *xmlNode) string {
+	if node.name.Local == "" {
+		return "text()"
+	}
+
+	return node.name.Local
+}
+
+func nodeLabel(node *xmlNode) string {
+	if node.name.Local == "" {
+		return "text " + strconv.Quote(node.text)
+	}
+
+	return "element <" + qualifiedName(node.name) + ">"
+}
+
+func qualifiedName(name xml.Name) string {
+	if name.Space == "" {
+		return name.Local
+	}
+
+	return "{" + name.Space + "}" + name.Local
+}
+
+func sortedAttrs(attrs ...map[string]string) []string {
+	seen := make(map[string]bool)
+	var keys []string
+	for _, m := range attrs {
+		for key := range m {
+			if !seen[key] {
+				seen[key] = true
+				keys = append(keys, key)
+			}
+		}
+	}
+
+	sort.Strings(keys)
+	return keys
+}
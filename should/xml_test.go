@@ -0,0 +1,77 @@
+package should
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestBeEqualXML(t *testing.T) {
+	t.Run("scenarios that must fail tests", func(t *testing.T) {
+		assertThat := func(assumption string, expected, actual interface{}, expectedLogMessage string) {
+			stub := testingStub{}
+			should := New(&stub)
+
+			should.BeEqualXML(expected, actual, assumption)
+
+			if !stub.hasFailed {
+				t.Error("test was expected to fail but did not")
+			}
+			if !stub.WasHelperCalled() {
+				t.Error("Helper() call was expected but did not happen")
+			}
+			if expectedLogMessage != stub.logMessage {
+				t.Errorf("wanted '%s' got '%s'", expectedLogMessage, stub.logMessage)
+			}
+		}
+
+		assertThat("should report differences as element paths",
+			`<order id="1"><item sku="a">2</item><item sku="b">1</item></order>`,
+			`<order id="2"><item sku="a">2</item><item sku="c">1</item><note/></order>`,
+			"\nassumption: [ should report differences as element paths ]\n    should: BeEqualXML \n    reason: documents differ"+
+				"\n  expected: <order id=\"1\"><item sku=\"a\">2</item><item sku=\"b\">1</item></order>"+
+				"\n    actual: <order id=\"2\"><item sku=\"a\">2</item><item sku=\"c\">1</item><note/></order>"+
+				"\n   differs: /order/@id: expected \"1\", actual \"2\""+
+				"\n            /order/item[2]/@sku: expected \"b\", actual \"c\""+
+				"\n            /order/note: unexpected")
+		assertThat("should report different elements and text",
+			`<a><b>x</b><c/></a>`, `<a><b>y</b><d/></a>`,
+			"\nassumption: [ should report different elements and text ]\n    should: BeEqualXML \n    reason: documents differ"+
+				"\n  expected: <a><b>x</b><c/></a>\n    actual: <a><b>y</b><d/></a>"+
+				"\n   differs: /a/b/text(): expected \"x\", actual \"y\""+
+				"\n            /a/c: expected element <c>, actual element <d>")
+		assertThat("should report invalid actual xml",
+			`<a/>`, `<a>`,
+			"\nassumption: [ should report invalid actual xml ]\n    should: BeEqualXML \n    reason: invalid actual XML: XML syntax error on line 1: unexpected EOF"+
+				"\n  expected: <a/>\n    actual: <a>")
+		assertThat("should report documents without root element",
+			`<!-- empty -->`, `<a/>`,
+			"\nassumption: [ should report documents without root element ]\n    should: BeEqualXML \n    reason: invalid expected XML: no root element"+
+				"\n  expected: <!-- empty -->\n    actual: <a/>")
+	})
+
+	t.Run("scenarios that must not fail tests", func(t *testing.T) {
+		assertThat := func(assumption string, expected, actual interface{}) {
+			stub := testingStub{}
+			should := New(&stub)
+
+			should.BeEqualXML(expected, actual, assumption)
+
+			if stub.hasFailed {
+				t.Error("test was expected to not fail but it did")
+			}
+			if stub.WasHelperCalled() {
+				t.Error("Helper() call was not expected")
+			}
+			if stub.logMessage != "" {
+				t.Errorf("wanted '%s' got '%s'", "", stub.logMessage)
+			}
+		}
+
+		assertThat("should not fail for different attribute order and whitespace",
+			`<a x="1" y="2"><b>text</b></a>`,
+			"<?xml version=\"1.0\"?>\n<a y=\"2\"\n   x=\"1\">\n\t<b> text </b>\n</a>")
+		assertThat("should not fail for different namespace prefixes and comments",
+			`<s:Envelope xmlns:s="urn:soap"><s:Body>ok</s:Body></s:Envelope>`,
+			strings.NewReader(`<e:Envelope xmlns:e="urn:soap"><!-- note --><e:Body>ok</e:Body></e:Envelope>`))
+	})
+}
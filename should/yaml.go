@@ -0,0 +1,697 @@
+package should
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+var (
+	yamlIntPattern   = regexp.MustCompile(`^[-+]?[0-9]+$`)
+	yamlFloatPattern = regexp.MustCompile(`^[-+]?(\.[0-9]+|[0-9]+(\.[0-9]*)?)([eE][-+]?[0-9]+)?$`)
+	yamlHexPattern   = regexp.MustCompile(`^0x[0-9a-fA-F]+$`)
+	yamlOctalPattern = regexp.MustCompile(`^0o[0-7]+$`)
+	yamlInfPattern   = regexp.MustCompile(`^[-+]?\.(inf|Inf|INF)$`)
+	yamlNaNPattern   = regexp.MustCompile(`^\.(nan|NaN|NAN)$`)
+
+	// yamlEscapes maps the single character escapes of double-quoted scalars to what they stand for.
+	yamlEscapes = map[byte]string{
+		'0': "\x00", 'a': "\a", 'b': "\b", 't': "\t", '\t': "\t", 'n': "\n", 'v': "\v", 'f': "\f", 'r': "\r",
+		'e': "\x1b", ' ': " ", '"': "\"", '/': "/", '\\': "\\", 'N': "\u0085", '_': "\u00a0", 'L': "\u2028", 'P': "\u2029",
+	}
+	// yamlUnicodeEscapes holds the number of hexadecimal digits following each unicode escape.
+	yamlUnicodeEscapes = map[byte]int{'x': 2, 'u': 4, 'U': 8}
+)
+
+// BeEqualYAML compares the YAML documents in expected and actual and fails the test if they are not semantically equal.
+// Key order, comments, quoting style and formatting are ignored. Both values can be a string, []byte or io.Reader.
+//
+// Only a subset of YAML is supported: block and flow collections, plain and quoted scalars,
+// literal and folded block scalars, and multiple documents separated by "---".
+// Anchors, aliases, tags and complex keys are reported as invalid YAML.
+func (s *Should) BeEqualYAML(expected, actual interface{}, assumption ...string) {
+	defer s.track("BeEqualYAML", assumption)()
+
+	expectedText, expectedDoc, expectedErr := decodeYAML(expected)
+	actualText, actualDoc, actualErr := decodeYAML(actual)
+
+	if expectedErr != nil || actualErr != nil {
+		s.t.Helper()
+		s.t.Log(fmt.Sprintf(reasonLogFormat, describe(s.assumed(assumption), "BeEqualYAML", "%[2]s equals YAML %[1]s"), "BeEqualYAML",
+			invalidDocumentReason("YAML", expectedErr, actualErr), escape(expectedText), escape(actualText)))
+		s.t.Fail()
+		return
+	}
+
+	differences := diffJSON("", expectedDoc, actualDoc)
+	if len(differences) > 0 {
+		s.t.Helper()
+		s.t.Log(fmt.Sprintf(differencesLogFormat, describe(s.assumed(assumption), "BeEqualYAML", "%[2]s equals YAML %[1]s"), "BeEqualYAML",
+			"documents differ", escape(expectedText), escape(actualText), strings.Join(differences, differencesIndent)))
+		s.t.Fail()
+	}
+}
+
+// decodeYAML reads value and parses it into the same structures encoding/json
+// produces with UseNumber, so that documents can be compared with diffJSON.
+// A stream with several documents is returned as a slice of documents.
+func decodeYAML(value interface{}) (text string, doc interface{}, err error) {
+	data, err := readDocument(value)
+	if err != nil {
+		return string(data), nil, err
+	}
+
+	var docs []interface{}
+	for _, lines := range splitYAMLDocuments(string(data)) {
+		p := &yamlParser{lines: lines}
+		node, err := p.parseNode(0)
+		if err != nil {
+			return string(data), nil, err
+		}
+		if p.skipBlank(); p.pos < len(p.lines) {
+			return string(data), nil, p.errorf("unexpected content")
+		}
+		docs = append(docs, node)
+	}
+
+	if len(docs) == 1 {
+		return string(data), docs[0], nil
+	}
+
+	return string(data), docs, nil
+}
+
+// splitYAMLDocuments splits a stream on "---" and "..." markers, dropping
+// documents that hold nothing but blank lines and comments.
+func splitYAMLDocuments(data string) [][]yamlLine {
+	var docs [][]yamlLine
+	var current []yamlLine
+	hasContent := false
+
+	flush := func() {
+		if hasContent {
+			docs = append(docs, current)
+		}
+		current, hasContent = nil, false
+	}
+
+	for i, raw := range strings.Split(strings.ReplaceAll(data, "\r\n", "\n"), "\n") {
+		if raw == "---" || strings.HasPrefix(raw, "--- ") || raw == "..." {
+			flush()
+			if rest := strings.TrimSpace(strings.TrimPrefix(raw, "---")); rest != "" && rest != "..." {
+				current, hasContent = append(current, yamlLine{number: i + 1, raw: rest}), true
+			}
+			continue
+		}
+
+		current = append(current, yamlLine{number: i + 1, raw: raw})
+		if content := stripYAMLComment(strings.TrimSpace(raw)); content != "" {
+			hasContent = true
+		}
+	}
+	flush()
+
+	if len(docs) == 0 {
+		docs = append(docs, nil)
+	}
+
+	return docs
+}
+
+type yamlLine struct {
+	number int
+	raw    string
+}
+
+type yamlParser struct {
+	lines []yamlLine
+	pos   int
+}
+
+func (p *yamlParser) errorf(format string, args ...interface{}) error {
+	line := 0
+	if p.pos < len(p.lines) {
+		line = p.lines[p.pos].number
+	} else if len(p.lines) > 0 {
+		line = p.lines[len(p.lines)-1].number
+	}
+
+	return fmt.Errorf("line %d: %s", line, fmt.Sprintf(format, args...))
+}
+
+// skipBlank advances past blank and comment-only lines.
+func (p *yamlParser) skipBlank() {
+	for p.pos < len(p.lines) && stripYAMLComment(strings.TrimSpace(p.lines[p.pos].raw)) == "" {
+		p.pos++
+	}
+}
+
+// current returns the indentation and content of the current line, with any
+// trailing comment removed.
+func (p *yamlParser) current() (indent int, content string, err error) {
+	raw := p.lines[p.pos].raw
+	trimmed := strings.TrimLeft(raw, " ")
+	if strings.HasPrefix(trimmed, "\t") {
+		return 0, "", p.errorf("tabs are not allowed for indentation")
+	}
+
+	return len(raw) - len(trimmed), stripYAMLComment(strings.TrimSpace(trimmed)), nil
+}
+
+// parseNode parses the node starting at the next non-blank line, which must
+// be indented by at least minIndent. A missing node is a null value.
+func (p *yamlParser) parseNode(minIndent int) (interface{}, error) {
+	p.skipBlank()
+	if p.pos >= len(p.lines) {
+		return nil, nil
+	}
+
+	indent, content, err := p.current()
+	if err != nil || indent < minIndent {
+		return nil, err
+	}
+
+	if isYAMLSequenceItem(content) {
+		return p.parseSequence(indent)
+	}
+	if _, _, ok := splitYAMLKey(content); ok {
+		return p.parseMapping(indent)
+	}
+	if isYAMLBlockScalar(content) {
+		p.pos++
+		return p.parseBlockScalar(content, indent-1)
+	}
+
+	return p.parseInline(content)
+}
+
+func (p *yamlParser) parseSequence(indent int) (interface{}, error) {
+	items := []interface{}{}
+	for p.skipBlank(); p.pos < len(p.lines); p.skipBlank() {
+		lineIndent, content, err := p.current()
+		if err != nil {
+			return nil, err
+		}
+		if lineIndent < indent || !isYAMLSequenceItem(content) {
+			break
+		}
+		if lineIndent > indent {
+			return nil, p.errorf("bad indentation of a sequence entry")
+		}
+
+		rest := strings.TrimLeft(content[1:], " ")
+		if rest == "" {
+			p.pos++
+			item, err := p.parseNode(indent + 1)
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, item)
+			continue
+		}
+
+		// Parse what follows the dash as if it started its own line, so that
+		// a mapping opened there continues on the lines below it.
+		column := indent + len(content) - len(rest)
+		p.lines[p.pos].raw = strings.Repeat(" ", column) + rest
+		item, err := p.parseSequenceItem(column, rest, indent)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+func (p *yamlParser) parseMapping(indent int) (interface{}, error) {
+	members := map[string]interface{}{}
+	for p.skipBlank(); p.pos < len(p.lines); p.skipBlank() {
+		lineIndent, content, err := p.current()
+		if err != nil {
+			return nil, err
+		}
+		if lineIndent < indent {
+			break
+		}
+		if lineIndent > indent {
+			return nil, p.errorf("bad indentation of a mapping entry")
+		}
+		if isYAMLSequenceItem(content) {
+			break
+		}
+
+		key, rest, ok := splitYAMLKey(content)
+		if !ok {
+			return nil, p.errorf("expected a mapping entry")
+		}
+		if _, exists := members[key]; exists {
+			return nil, p.errorf("duplicate key %q", key)
+		}
+
+		var value interface{}
+		switch {
+		case rest == "":
+			p.pos++
+			value, err = p.parseMappingValue(indent)
+		case isYAMLBlockScalar(rest):
+			p.pos++
+			value, err = p.parseBlockScalar(rest, indent)
+		default:
+			value, err = p.parseInline(rest)
+		}
+		if err != nil {
+			return nil, err
+		}
+		members[key] = value
+	}
+
+	return members, nil
+}
+
+// parseMappingValue parses a value written on the lines below its key. Sequences
+// may sit at the same indentation as the key, everything else must be nested.
+func (p *yamlParser) parseMappingValue(indent int) (interface{}, error) {
+	p.skipBlank()
+	if p.pos >= len(p.lines) {
+		return nil, nil
+	}
+
+	lineIndent, content, err := p.current()
+	if err != nil {
+		return nil, err
+	}
+	if lineIndent == indent && isYAMLSequenceItem(content) {
+		return p.parseSequence(indent)
+	}
+
+	return p.parseNode(indent + 1)
+}
+
+// parseSequenceItem parses an entry that starts on the same line as its dash,
+// at column. It may be a block scalar, a nested collection or an inline value.
+func (p *yamlParser) parseSequenceItem(column int, content string, sequenceIndent int) (interface{}, error) {
+	if isYAMLBlockScalar(content) {
+		p.pos++
+		return p.parseBlockScalar(content, sequenceIndent)
+	}
+	if isYAMLSequenceItem(content) {
+		return p.parseSequence(column)
+	}
+	if _, _, ok := splitYAMLKey(content); ok {
+		return p.parseMapping(column)
+	}
+
+	return p.parseInline(content)
+}
+
+// parseInline parses content from the current line and moves to the next one.
+func (p *yamlParser) parseInline(content string) (interface{}, error) {
+	value, err := parseYAMLInline(content)
+	if err != nil {
+		return nil, p.errorf("%v", err)
+	}
+
+	p.pos++
+	return value, nil
+}
+
+// parseBlockScalar parses a literal (|) or folded (>) scalar whose lines are
+// indented deeper than parentIndent.
+func (p *yamlParser) parseBlockScalar(header string, parentIndent int) (interface{}, error) {
+	style, chomping := header[0], header[1:]
+	if chomping != "" && chomping != "-" && chomping != "+" {
+		return nil, p.errorf("unsupported block scalar header %q", header)
+	}
+
+	var lines []string
+	blockIndent := -1
+	for ; p.pos < len(p.lines); p.pos++ {
+		raw := p.lines[p.pos].raw
+		trimmed := strings.TrimLeft(raw, " ")
+		indent := len(raw) - len(trimmed)
+
+		if trimmed == "" {
+			lines = append(lines, "")
+			continue
+		}
+		if indent <= parentIndent {
+			break
+		}
+		if blockIndent < 0 {
+			blockIndent = indent
+		}
+		if indent < blockIndent {
+			return nil, p.errorf("bad indentation of a block scalar")
+		}
+		lines = append(lines, raw[blockIndent:])
+	}
+
+	content := strings.Join(lines, "\n")
+	if style == '>' {
+		content = foldYAMLLines(lines)
+	}
+
+	body := strings.TrimRight(content, "\n")
+	switch {
+	case chomping == "-" || body == "":
+		return body, nil
+	case chomping == "+":
+		return content + "\n", nil
+	}
+
+	return body + "\n", nil
+}
+
+// foldYAMLLines joins lines with spaces, except around blank and more
+// indented lines, which keep their line breaks.
+func foldYAMLLines(lines []string) string {
+	var b strings.Builder
+	for i, line := range lines {
+		if i > 0 {
+			previous := lines[i-1]
+			if previous == "" || line == "" || strings.HasPrefix(line, " ") || strings.HasPrefix(previous, " ") {
+				b.WriteString("\n")
+			} else {
+				b.WriteString(" ")
+			}
+		}
+		b.WriteString(line)
+	}
+
+	return b.String()
+}
+
+// parseYAMLInline parses a flow collection or a scalar that fits on one line.
+func parseYAMLInline(content string) (interface{}, error) {
+	if strings.HasPrefix(content, "[") || strings.HasPrefix(content, "{") {
+		f := &yamlFlow{input: content}
+		value, err := f.parse()
+		if err != nil {
+			return nil, err
+		}
+		if f.skipSpaces(); f.pos < len(f.input) {
+			return nil, fmt.Errorf("unexpected %q after flow collection", f.input[f.pos:])
+		}
+		return value, nil
+	}
+
+	return parseYAMLScalar(content)
+}
+
+// parseYAMLScalar resolves a scalar following the YAML 1.2 core schema.
+// Numbers are returned as json.Number, in decimal for hexadecimal and octal
+// integers, and as "+Inf", "-Inf" or "NaN" for infinities and not-a-number.
+func parseYAMLScalar(content string) (interface{}, error) {
+	if content == "" {
+		return nil, nil
+	}
+
+	switch content[0] {
+	case '"':
+		value, ok := unquoteYAML(content)
+		if !ok {
+			return nil, fmt.Errorf("invalid double-quoted scalar %s", content)
+		}
+		return value, nil
+	case '\'':
+		if len(content) < 2 || content[len(content)-1] != '\'' {
+			return nil, fmt.Errorf("invalid single-quoted scalar %s", content)
+		}
+		return strings.ReplaceAll(content[1:len(content)-1], "''", "'"), nil
+	case '&', '*', '!':
+		return nil, fmt.Errorf("anchors, aliases and tags are not supported: %s", content)
+	}
+
+	switch content {
+	case "~", "null", "Null", "NULL":
+		return nil, nil
+	case "true", "True", "TRUE":
+		return true, nil
+	case "false", "False", "FALSE":
+		return false, nil
+	}
+
+	switch {
+	case yamlIntPattern.MatchString(content) || yamlFloatPattern.MatchString(content):
+		return json.Number(strings.TrimPrefix(content, "+")), nil
+	case yamlHexPattern.MatchString(content):
+		n, _ := new(big.Int).SetString(content[2:], 16)
+		return json.Number(n.String()), nil
+	case yamlOctalPattern.MatchString(content):
+		n, _ := new(big.Int).SetString(content[2:], 8)
+		return json.Number(n.String()), nil
+	case yamlInfPattern.MatchString(content):
+		if content[0] == '-' {
+			return json.Number("-Inf"), nil
+		}
+		return json.Number("+Inf"), nil
+	case yamlNaNPattern.MatchString(content):
+		return json.Number("NaN"), nil
+	}
+
+	return content, nil
+}
+
+// unquoteYAML decodes a double-quoted scalar, including its surrounding quotes,
+// reporting false if it is not terminated or has an invalid escape.
+func unquoteYAML(content string) (string, bool) {
+	if len(content) < 2 || content[len(content)-1] != '"' {
+		return "", false
+	}
+
+	var b strings.Builder
+	for i := 1; i < len(content)-1; i++ {
+		switch c := content[i]; c {
+		case '"':
+			return "", false
+		case '\\':
+			i++
+			if i == len(content)-1 {
+				return "", false
+			}
+			if escaped, ok := yamlEscapes[content[i]]; ok {
+				b.WriteString(escaped)
+				continue
+			}
+			digits, ok := yamlUnicodeEscapes[content[i]]
+			if !ok || i+digits >= len(content)-1 {
+				return "", false
+			}
+			r, err := strconv.ParseUint(content[i+1:i+1+digits], 16, 32)
+			if err != nil || !utf8.ValidRune(rune(r)) {
+				return "", false
+			}
+			b.WriteRune(rune(r))
+			i += digits
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return b.String(), true
+}
+
+// yamlFlow parses flow collections such as [a, b] and {a: 1}.
+type yamlFlow struct {
+	input string
+	pos   int
+}
+
+func (f *yamlFlow) skipSpaces() {
+	for f.pos < len(f.input) && f.input[f.pos] == ' ' {
+		f.pos++
+	}
+}
+
+func (f *yamlFlow) parse() (interface{}, error) {
+	f.skipSpaces()
+	if f.pos >= len(f.input) {
+		return nil, errors.New("unterminated flow collection")
+	}
+
+	switch f.input[f.pos] {
+	case '[':
+		f.pos++
+		items := []interface{}{}
+		for {
+			if f.skipSpaces(); f.pos < len(f.input) && f.input[f.pos] == ']' {
+				f.pos++
+				return items, nil
+			}
+
+			item, err := f.parse()
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, item)
+
+			if err := f.separator(']'); err != nil {
+				return nil, err
+			}
+		}
+
+	case '{':
+		f.pos++
+		members := map[string]interface{}{}
+		for {
+			if f.skipSpaces(); f.pos < len(f.input) && f.input[f.pos] == '}' {
+				f.pos++
+				return members, nil
+			}
+
+			key, err := f.scalar(true)
+			if err != nil {
+				return nil, err
+			}
+			if f.skipSpaces(); f.pos >= len(f.input) || f.input[f.pos] != ':' {
+				return nil, fmt.Errorf("expected ':' after flow mapping key %q", key)
+			}
+			f.pos++
+
+			value, err := f.parse()
+			if err != nil {
+				return nil, err
+			}
+			members[fmt.Sprint(key)] = value
+
+			if err := f.separator('}'); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return f.scalar(false)
+}
+
+// separator consumes the comma between entries, leaving the closing
+// character for the caller.
+func (f *yamlFlow) separator(closing byte) error {
+	f.skipSpaces()
+	if f.pos >= len(f.input) {
+		return errors.New("unterminated flow collection")
+	}
+
+	switch f.input[f.pos] {
+	case ',':
+		f.pos++
+		return nil
+	case closing:
+		return nil
+	}
+
+	return fmt.Errorf("unexpected %q in flow collection", f.input[f.pos])
+}
+
+func (f *yamlFlow) scalar(isKey bool) (interface{}, error) {
+	f.skipSpaces()
+	start := f.pos
+	if f.pos < len(f.input) && (f.input[f.pos] == '"' || f.input[f.pos] == '\'') {
+		end := quotedYAMLEnd(f.input, f.pos)
+		if end < 0 {
+			return nil, errors.New("unterminated quoted scalar")
+		}
+		f.pos = end
+		return parseYAMLScalar(f.input[start:end])
+	}
+
+	for f.pos < len(f.input) && !strings.ContainsRune(",[]{}", rune(f.input[f.pos])) &&
+		!(isKey && f.input[f.pos] == ':') {
+		f.pos++
+	}
+
+	return parseYAMLScalar(strings.TrimSpace(f.input[start:f.pos]))
+}
+
+func isYAMLBlockScalar(content string) bool {
+	return strings.HasPrefix(content, "|") || strings.HasPrefix(content, ">")
+}
+
+func isYAMLSequenceItem(content string) bool {
+	return content == "-" || strings.HasPrefix(content, "- ")
+}
+
+// splitYAMLKey splits a "key: value" entry, honouring quoted keys.
+func splitYAMLKey(content string) (key, rest string, ok bool) {
+	if strings.HasPrefix(content, "? ") {
+		return "", "", false
+	}
+
+	end := 0
+	if strings.HasPrefix(content, "\"") || strings.HasPrefix(content, "'") {
+		if end = quotedYAMLEnd(content, 0); end < 0 {
+			return "", "", false
+		}
+	}
+
+	for i := end; i < len(content); i++ {
+		if content[i] != ':' || (i+1 < len(content) && content[i+1] != ' ') {
+			continue
+		}
+
+		rawKey := strings.TrimSpace(content[:i])
+		if rawKey == "" || strings.HasPrefix(rawKey, "{") || strings.HasPrefix(rawKey, "[") {
+			return "", "", false
+		}
+
+		parsedKey, err := parseYAMLScalar(rawKey)
+		if err != nil {
+			return "", "", false
+		}
+		if parsedKey == nil {
+			parsedKey = "null"
+		}
+
+		return fmt.Sprint(parsedKey), strings.TrimSpace(content[i+1:]), true
+	}
+
+	return "", "", false
+}
+
+// quotedYAMLEnd returns the index just after the quoted scalar starting at
+// start, or -1 when it is not terminated.
+func quotedYAMLEnd(content string, start int) int {
+	quote := content[start]
+	for i := start + 1; i < len(content); i++ {
+		switch {
+		case quote == '"' && content[i] == '\\':
+			i++
+		case content[i] == quote && quote == '\'' && i+1 < len(content) && content[i+1] == '\'':
+			i++
+		case content[i] == quote:
+			return i + 1
+		}
+	}
+
+	return -1
+}
+
+// stripYAMLComment removes a trailing comment that is outside of quotes.
+func stripYAMLComment(content string) string {
+	var quote byte
+	for i := 0; i < len(content); i++ {
+		c := content[i]
+		switch {
+		case quote != 0:
+			if c == '\\' && quote == '"' {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			if i == 0 || strings.ContainsRune(" [{,:", rune(content[i-1])) {
+				quote = c
+			}
+		case c == '#':
+			if i == 0 || content[i-1] == ' ' {
+				return strings.TrimSpace(content[:i])
+			}
+		}
+	}
+
+	return content
+}
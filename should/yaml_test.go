@@ -0,0 +1,120 @@
+package should
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestBeEqualYAML(t *testing.T) {
+	t.Run("scenarios that must fail tests", func(t *testing.T) {
+		assertThat := func(assumption string, expected, actual interface{}, expectedLogMessage string) {
+			stub := testingStub{}
+			should := New(&stub)
+
+			should.BeEqualYAML(expected, actual, assumption)
+
+			if !stub.hasFailed {
+				t.Error("test was expected to fail but did not")
+			}
+			if !stub.WasHelperCalled() {
+				t.Error("Helper() call was expected but did not happen")
+			}
+			if expectedLogMessage != stub.logMessage {
+				t.Errorf("wanted '%s' got '%s'", expectedLogMessage, stub.logMessage)
+			}
+		}
+
+		assertThat("should report differences as paths",
+			"spec:\n  replicas: 2\n  containers:\n  - name: app\n    image: app:1\n",
+			"spec:\n  replicas: 3\n  containers:\n  - name: app\n    image: app:2\n",
+			"\nassumption: [ should report differences as paths ]\n    should: BeEqualYAML \n    reason: documents differ"+
+				"\n  expected: spec:\\n  replicas: 2\\n  containers:\\n  - name: app\\n    image: app:1\\n"+
+				"\n    actual: spec:\\n  replicas: 3\\n  containers:\\n  - name: app\\n    image: app:2\\n"+
+				"\n   differs: /spec/containers/0/image: expected \"app:1\", actual \"app:2\""+
+				"\n            /spec/replicas: expected 2, actual 3")
+		assertThat("should report typed scalar differences",
+			"enabled: true\nport: 80", "enabled: \"true\"\nport: 80",
+			"\nassumption: [ should report typed scalar differences ]\n    should: BeEqualYAML \n    reason: documents differ"+
+				"\n  expected: enabled: true\\nport: 80\n    actual: enabled: \"true\"\\nport: 80"+
+				"\n   differs: /enabled: expected true, actual \"true\"")
+		assertThat("should report unsupported features as invalid yaml",
+			"a: &anchor 1", "a: 1",
+			"\nassumption: [ should report unsupported features as invalid yaml ]\n    should: BeEqualYAML \n    reason: invalid expected YAML: line 1: anchors, aliases and tags are not supported: &anchor 1"+
+				"\n  expected: a: &anchor 1\n    actual: a: 1")
+		assertThat("should report escapes that yaml does not define as invalid yaml",
+			`a: "\101"`, `a: "A"`,
+			"\nassumption: [ should report escapes that yaml does not define as invalid yaml ]\n    should: BeEqualYAML \n    reason: invalid expected YAML: line 1: invalid double-quoted scalar \"\\101\""+
+				"\n  expected: a: \"\\101\"\n    actual: a: \"A\"")
+		assertThat("should report duplicate keys as invalid yaml",
+			"a: 1", "a: 1\na: 2",
+			"\nassumption: [ should report duplicate keys as invalid yaml ]\n    should: BeEqualYAML \n    reason: invalid actual YAML: line 2: duplicate key \"a\""+
+				"\n  expected: a: 1\n    actual: a: 1\\na: 2")
+	})
+
+	t.Run("scenarios that must not fail tests", func(t *testing.T) {
+		assertThat := func(assumption string, expected, actual interface{}) {
+			stub := testingStub{}
+			should := New(&stub)
+
+			should.BeEqualYAML(expected, actual, assumption)
+
+			if stub.hasFailed {
+				t.Error("test was expected to not fail but it did")
+			}
+			if stub.WasHelperCalled() {
+				t.Error("Helper() call was not expected")
+			}
+			if stub.logMessage != "" {
+				t.Errorf("wanted '%s' got '%s'", "", stub.logMessage)
+			}
+		}
+
+		assertThat("should not fail for different key order, comments and styles",
+			"# manifest\nkind: Pod\nmetadata:\n  labels: {app: web, tier: 'front'}\nspec:\n  ports: [80, 443]\n",
+			"kind: \"Pod\" # inline comment\nspec:\n  ports:\n    - 80\n    - 443.0\nmetadata:\n  labels:\n    tier: front\n    app: web\n")
+		assertThat("should not fail for equivalent block scalars",
+			"script: |\n  echo a\n  echo b\nnote: >-\n  folded\n  text\n",
+			[]byte("script: \"echo a\\necho b\\n\"\nnote: folded text"))
+		assertThat("should not fail for equivalent core schema numbers",
+			"mode: 0o755\nmask: 0xFF\nlimit: .inf\n", "mode: 493\nmask: 255\nlimit: +.Inf\n")
+		assertThat("should not fail for multiple documents",
+			"---\nkind: A\n---\nkind: B\n",
+			"kind: A\n---\n# second\nkind: B\n...\n")
+	})
+}
+
+func TestDecodeYAML(t *testing.T) {
+	assertThat := func(assumption string, input string, expected interface{}) {
+		_, actual, err := decodeYAML(input)
+		if err != nil {
+			t.Errorf("%s: unexpected error %v", assumption, err)
+		}
+		if !reflect.DeepEqual(expected, actual) {
+			t.Errorf("%s: wanted '%#v' got '%#v'", assumption, expected, actual)
+		}
+	}
+
+	assertThat("should parse nested sequences and mappings",
+		"- - a\n  - b\n- name: x\n  args: [\"-v\", 'it''s']\n- ~\n-\n",
+		[]interface{}{
+			[]interface{}{"a", "b"},
+			map[string]interface{}{"name": "x", "args": []interface{}{"-v", "it's"}},
+			nil,
+			nil,
+		})
+	assertThat("should keep trailing newlines for keep chomping",
+		"a: |+\n  x\n\nb: 1\n",
+		map[string]interface{}{"a": "x\n\n", "b": json.Number("1")})
+	assertThat("should parse urls and hashes inside values",
+		"url: http://host:80/#anchor\ncolor: '#fff'\n",
+		map[string]interface{}{"url": "http://host:80/#anchor", "color": "#fff"})
+	assertThat("should parse empty documents as null", "# nothing\n", nil)
+	assertThat("should decode yaml escapes in double-quoted scalars",
+		`path: "a\/b\tc\x41\u00e9\U0001F600\_\N\e\"\\"`,
+		map[string]interface{}{"path": "a/b\tcA\u00e9\U0001F600\u00a0\u0085\x1b\"\\"})
+	assertThat("should resolve core schema numbers",
+		"[0x1F, 0o17, .inf, -.Inf, +.INF, .NaN, 0x, .infinity]",
+		[]interface{}{json.Number("31"), json.Number("15"), json.Number("+Inf"), json.Number("-Inf"),
+			json.Number("+Inf"), json.Number("NaN"), "0x", ".infinity"})
+}